@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
@@ -37,23 +38,79 @@ func SchemaResourceGroupNameForDataSource() *pluginsdk.Schema {
 	}
 }
 
+// SchemaResourceGroupNameOptional returns the schema for an optional Resource Group Name, for Data Sources which
+// can look up a resource without it (and then set the Resource Group Name which was found). An empty string is
+// treated the same as omitting the field, any other value must be a valid Resource Group Name.
+//
+// This is used by the `azurerm_dns_zone`, `azurerm_private_dns_zone` and `azurerm_resources` Data Sources.
+func SchemaResourceGroupNameOptional() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:         pluginsdk.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validateResourceGroupNameOrEmpty,
+	}
+}
+
 func ValidateResourceGroupName(v interface{}, k string) (warnings []string, errors []error) {
-	value := v.(string)
+	return ValidateResourceGroupNameWithMaxLength(90)(v, k)
+}
 
-	if len(value) > 90 {
-		errors = append(errors, fmt.Errorf("%q may not exceed 90 characters in length", k))
+func validateResourceGroupNameOrEmpty(v interface{}, k string) (warnings []string, errors []error) {
+	if v.(string) == "" {
+		return
 	}
 
-	if strings.HasSuffix(value, ".") {
-		errors = append(errors, fmt.Errorf("%q may not end with a period", k))
-	}
+	return ValidateResourceGroupName(v, k)
+}
+
+// ValidateResourceGroupNameStrict validates a Resource Group Name as ValidateResourceGroupName does, but additionally
+// rejects names starting with an underscore, dash, period or opening parenthesis - which the API accepts, but which
+// the Portal (and Azure Policy in some tenants) rejects
+func ValidateResourceGroupNameStrict(v interface{}, k string) (warnings []string, errors []error) {
+	warnings, errors = ValidateResourceGroupName(v, k)
 
-	if len(value) == 0 {
-		errors = append(errors, fmt.Errorf("%q cannot be blank", k))
-	} else if matched := regexp.MustCompile(`^[-\w._()]+$`).Match([]byte(value)); !matched {
-		// regex pulled from https://docs.microsoft.com/en-us/rest/api/resources/resourcegroups/createorupdate
-		errors = append(errors, fmt.Errorf("%q may only contain alphanumeric characters, dash, underscores, parentheses and periods", k))
+	if value := v.(string); value != "" && strings.ContainsAny(value[:1], "_-.(") {
+		errors = append(errors, fmt.Errorf("%q may not start with an underscore, dash, period or opening parenthesis", k))
 	}
 
 	return warnings, errors
 }
+
+// ValidateResourceGroupNameWithMaxLength returns a SchemaValidateFunc which validates a Resource Group Name
+// with a tighter length limit, for resources which embed the Resource Group Name within a longer name
+func ValidateResourceGroupNameWithMaxLength(max int) pluginsdk.SchemaValidateFunc {
+	return func(v interface{}, k string) (warnings []string, errors []error) {
+		value := v.(string)
+
+		if utf8.RuneCountInString(value) > max {
+			errors = append(errors, fmt.Errorf("%q may not exceed %d characters in length", k, max))
+		}
+
+		if strings.HasSuffix(value, ".") {
+			errors = append(errors, fmt.Errorf("%q may not end with a period", k))
+		}
+
+		if len(value) == 0 {
+			errors = append(errors, fmt.Errorf("%q cannot be blank", k))
+		} else if matched := regexp.MustCompile(`^[-\p{L}\p{N}_.()]+$`).Match([]byte(value)); !matched {
+			// regex pulled from https://docs.microsoft.com/en-us/rest/api/resources/resourcegroups/createorupdate
+			// `\w` is ASCII-only in RE2, so Unicode letters and digits are matched explicitly since Azure allows them
+			errors = append(errors, fmt.Errorf("%q may only contain alphanumeric characters, dash, underscores, parentheses and periods", k))
+		}
+
+		return warnings, errors
+	}
+}
+
+// NormalizeResourceGroupName returns the Resource Group Name in a consistent form - with surrounding whitespace and
+// trailing periods removed, and lower-cased - since Resource Group Names are case-insensitive and the casing returned
+// by the API isn't guaranteed to match the casing used when the Resource Group was created.
+//
+// This is intended for comparing Resource Group Names and building composite names/IDs, rather than for setting
+// into the state directly (where the casing returned from the API should be used).
+func NormalizeResourceGroupName(input string) string {
+	output := strings.TrimSpace(input)
+	output = strings.TrimRight(output, ".")
+	return strings.ToLower(output)
+}
@@ -47,6 +47,37 @@ func TestValidateResourceGroupName(t *testing.T) {
 			Value:    "EndingWithAPeriod.",
 			ErrCount: 1,
 		},
+		{
+			Value:    "rg-münchen",
+			ErrCount: 0,
+		},
+		{
+			Value:    "группа-ресурсов",
+			ErrCount: 0,
+		},
+		{
+			Value:    "リソースグループ1",
+			ErrCount: 0,
+		},
+		{
+			Value:    "rg-münchen.",
+			ErrCount: 1,
+			Message:  "may not end with a period",
+		},
+		{
+			Value:    "rg-münchen!",
+			ErrCount: 1,
+			Message:  "may only contain",
+		},
+		{
+			Value:    strings.Repeat("ü", 90),
+			ErrCount: 0,
+		},
+		{
+			Value:    strings.Repeat("ü", 91),
+			ErrCount: 1,
+			Message:  "may not exceed 90 characters",
+		},
 		{
 			Value:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/foo",
 			ErrCount: 1,
@@ -58,6 +89,7 @@ func TestValidateResourceGroupName(t *testing.T) {
 		{
 			Value:    acceptance.RandString(91),
 			ErrCount: 1,
+			Message:  "may not exceed 90 characters",
 		},
 	}
 
@@ -77,3 +109,210 @@ func TestValidateResourceGroupName(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateResourceGroupNameWithMaxLength(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+		Message  string
+	}{
+		{
+			Value:    "",
+			ErrCount: 1,
+			Message:  "cannot be blank",
+		},
+		{
+			Value:    "hello",
+			ErrCount: 0,
+		},
+		{
+			Value:    "EndingWithAPeriod.",
+			ErrCount: 1,
+			Message:  "may not end with a period",
+		},
+		{
+			Value:    acceptance.RandString(40),
+			ErrCount: 0,
+		},
+		{
+			Value:    acceptance.RandString(41),
+			ErrCount: 1,
+			Message:  "may not exceed 40 characters",
+		},
+		{
+			Value:    acceptance.RandString(90),
+			ErrCount: 1,
+			Message:  "may not exceed 40 characters",
+		},
+	}
+
+	validateFunc := azure.ValidateResourceGroupNameWithMaxLength(40)
+	for _, tc := range cases {
+		_, errors := validateFunc(tc.Value, "azurerm_resource_group")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected "+
+				"ValidateResourceGroupNameWithMaxLength to trigger '%d' errors for '%s' - got '%d'", tc.ErrCount, tc.Value, len(errors))
+		} else if len(errors) == 1 && tc.Message != "" {
+			errorMessage := errors[0].Error()
+
+			if !strings.Contains(errorMessage, tc.Message) {
+				t.Fatalf("Expected "+
+					"ValidateResourceGroupNameWithMaxLength to report an error including '%s' for '%s' - got '%s'", tc.Message, tc.Value, errorMessage)
+			}
+		}
+	}
+}
+
+func TestValidateResourceGroupNameStrict(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+		Message  string
+	}{
+		{
+			Value:    "",
+			ErrCount: 1,
+			Message:  "cannot be blank",
+		},
+		{
+			Value:    "hello",
+			ErrCount: 0,
+		},
+		{
+			Value:    "Hello_World",
+			ErrCount: 0,
+		},
+		{
+			Value:    "hello-world.(1)",
+			ErrCount: 0,
+		},
+		{
+			Value:    "1hello",
+			ErrCount: 0,
+		},
+		{
+			Value:    "rg-münchen",
+			ErrCount: 0,
+		},
+		{
+			Value:    "_hello",
+			ErrCount: 1,
+			Message:  "may not start with",
+		},
+		{
+			Value:    "-hello",
+			ErrCount: 1,
+			Message:  "may not start with",
+		},
+		{
+			Value:    ".hello",
+			ErrCount: 1,
+			Message:  "may not start with",
+		},
+		{
+			Value:    "(hello)",
+			ErrCount: 1,
+			Message:  "may not start with",
+		},
+		{
+			Value:    "_hello.",
+			ErrCount: 2,
+		},
+		{
+			Value:    acceptance.RandString(91),
+			ErrCount: 1,
+			Message:  "may not exceed 90 characters",
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := azure.ValidateResourceGroupNameStrict(tc.Value, "azurerm_resource_group")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected "+
+				"ValidateResourceGroupNameStrict to trigger '%d' errors for '%s' - got '%d'", tc.ErrCount, tc.Value, len(errors))
+		} else if len(errors) == 1 && tc.Message != "" {
+			errorMessage := errors[0].Error()
+
+			if !strings.Contains(errorMessage, tc.Message) {
+				t.Fatalf("Expected "+
+					"ValidateResourceGroupNameStrict to report an error including '%s' for '%s' - got '%s'", tc.Message, tc.Value, errorMessage)
+			}
+		}
+	}
+}
+
+func TestNormalizeResourceGroupName(t *testing.T) {
+	cases := []struct {
+		Input    string
+		Expected string
+	}{
+		{
+			Input:    "",
+			Expected: "",
+		},
+		{
+			Input:    "hello",
+			Expected: "hello",
+		},
+		{
+			Input:    "Hello-World",
+			Expected: "hello-world",
+		},
+		{
+			Input:    "HELLO_WORLD.(1)",
+			Expected: "hello_world.(1)",
+		},
+		{
+			Input:    "hello.",
+			Expected: "hello",
+		},
+		{
+			Input:    "Hello...",
+			Expected: "hello",
+		},
+		{
+			Input:    "hello.world",
+			Expected: "hello.world",
+		},
+		{
+			Input:    " Hello. ",
+			Expected: "hello",
+		},
+		{
+			Input:    "RG-München",
+			Expected: "rg-münchen",
+		},
+	}
+
+	for _, tc := range cases {
+		if actual := azure.NormalizeResourceGroupName(tc.Input); actual != tc.Expected {
+			t.Fatalf("Expected NormalizeResourceGroupName to return %q for %q - got %q", tc.Expected, tc.Input, actual)
+		}
+	}
+}
+
+func TestSchemaResourceGroupNameOptional(t *testing.T) {
+	s := azure.SchemaResourceGroupNameOptional()
+
+	if !s.Optional || s.Required || s.ForceNew {
+		t.Fatalf("Expected the Resource Group Name to be Optional and not ForceNew")
+	}
+
+	if s.ValidateFunc == nil {
+		t.Fatalf("Expected the Resource Group Name to have a ValidateFunc")
+	}
+
+	if _, errors := s.ValidateFunc("", "resource_group_name"); len(errors) != 0 {
+		t.Fatalf("Expected an empty Resource Group Name to be accepted but got %+v", errors)
+	}
+
+	if _, errors := s.ValidateFunc("example.", "resource_group_name"); len(errors) == 0 {
+		t.Fatalf("Expected an invalid Resource Group Name to be rejected")
+	}
+
+	if _, errors := s.ValidateFunc("example-resources", "resource_group_name"); len(errors) != 0 {
+		t.Fatalf("Expected a valid Resource Group Name to be accepted but got %+v", errors)
+	}
+}
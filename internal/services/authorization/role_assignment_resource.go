@@ -212,7 +212,7 @@ func resourceArmRoleAssignmentCreate(d *pluginsdk.ResourceData, meta interface{}
 		properties.RoleAssignmentProperties.Condition = utils.String(condition)
 		properties.RoleAssignmentProperties.ConditionVersion = utils.String(conditionVersion)
 	} else if condition != "" || conditionVersion != "" {
-		return fmt.Errorf("`condition` and `conditionVersion` should be both set or unset")
+		return fmt.Errorf("`condition` and `condition_version` should be both set or unset")
 	}
 
 	skipPrincipalCheck := d.Get("skip_service_principal_aad_check").(bool)
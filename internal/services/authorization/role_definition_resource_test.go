@@ -30,6 +30,24 @@ func TestAccRoleDefinition_basic(t *testing.T) {
 	})
 }
 
+func TestAccRoleDefinition_dataActions(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_role_definition", "test")
+	r := RoleDefinitionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.dataActions(uuid.New().String(), data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("permissions.0.data_actions.#").HasValue("2"),
+				check.That(data.ResourceName).Key("permissions.0.not_data_actions.#").HasValue("1"),
+				check.That(data.ResourceName).Key("assignable_scopes.#").HasValue("2"),
+			),
+		},
+		data.ImportStep("role_definition_id", "scope"),
+	})
+}
+
 func TestAccRoleDefinition_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_role_definition", "test")
 	id := uuid.New().String()
@@ -407,6 +425,53 @@ resource "azurerm_role_definition" "test" {
 `, id, data.RandomInteger)
 }
 
+func (RoleDefinitionResource) dataActions(id string, data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_subscription" "primary" {
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_resource_group" "other" {
+  name     = "acctestRG-other-%d"
+  location = "%s"
+}
+
+resource "azurerm_role_definition" "test" {
+  role_definition_id = "%s"
+  name               = "acctestrd-%d"
+  scope              = azurerm_resource_group.test.id
+  description        = "Acceptance Test Data Plane Role Definition"
+
+  permissions {
+    actions = [
+      "Microsoft.Storage/storageAccounts/blobServices/containers/read",
+    ]
+    data_actions = [
+      "Microsoft.Storage/storageAccounts/blobServices/containers/blobs/read",
+      "Microsoft.Storage/storageAccounts/blobServices/containers/blobs/write",
+    ]
+    not_actions = []
+    not_data_actions = [
+      "Microsoft.Storage/storageAccounts/blobServices/containers/blobs/delete",
+    ]
+  }
+
+  assignable_scopes = [
+    azurerm_resource_group.test.id,
+    azurerm_resource_group.other.id,
+  ]
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.Locations.Primary, id, data.RandomInteger)
+}
+
 func (RoleDefinitionResource) assignToSmallerScope(id string, data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
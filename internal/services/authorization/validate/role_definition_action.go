@@ -0,0 +1,33 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// RoleDefinitionAction validates an entry in the `actions`, `not_actions`, `data_actions` or `not_data_actions` of a
+// Role Definition, e.g. `Microsoft.Storage/storageAccounts/blobServices/containers/blobs/read`, `Microsoft.Compute/*`
+// or `*`. See https://docs.microsoft.com/en-us/azure/role-based-access-control/role-definitions#actions-format
+func RoleDefinitionAction(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if v == "" {
+		errors = append(errors, fmt.Errorf("%q must not be empty", k))
+		return
+	}
+
+	if !regexp.MustCompile(`^[a-zA-Z0-9._*-]+(/[a-zA-Z0-9._*-]+)*$`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%q must be made up of `/` separated segments containing only letters, numbers, periods, underscores, hyphens and the `*` wildcard, got %q", k, v))
+	}
+
+	if strings.Contains(v, "**") {
+		errors = append(errors, fmt.Errorf("%q must not contain consecutive `*` wildcards, got %q", k, v))
+	}
+
+	return warnings, errors
+}
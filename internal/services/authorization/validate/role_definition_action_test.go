@@ -0,0 +1,86 @@
+package validate
+
+import "testing"
+
+func TestRoleDefinitionAction(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		errors int
+	}{
+		{
+			name:   "Empty value",
+			input:  "",
+			errors: 1,
+		},
+		{
+			name:   "Wildcard",
+			input:  "*",
+			errors: 0,
+		},
+		{
+			name:   "Wildcard read",
+			input:  "*/read",
+			errors: 0,
+		},
+		{
+			name:   "Resource Provider wildcard",
+			input:  "Microsoft.Compute/*",
+			errors: 0,
+		},
+		{
+			name:   "Wildcard within the path",
+			input:  "Microsoft.Authorization/*/read",
+			errors: 0,
+		},
+		{
+			name:   "Action",
+			input:  "Microsoft.Compute/virtualMachines/start/action",
+			errors: 0,
+		},
+		{
+			name:   "Data Action",
+			input:  "Microsoft.Storage/storageAccounts/blobServices/containers/blobs/read",
+			errors: 0,
+		},
+		{
+			name:   "Partial segment wildcard",
+			input:  "Microsoft.Storage/storageAccounts/blobServices/containers/blobs/*",
+			errors: 0,
+		},
+		{
+			name:   "Leading slash",
+			input:  "/Microsoft.Compute/*",
+			errors: 1,
+		},
+		{
+			name:   "Trailing slash",
+			input:  "Microsoft.Compute/",
+			errors: 1,
+		},
+		{
+			name:   "Empty segment",
+			input:  "Microsoft.Compute//read",
+			errors: 1,
+		},
+		{
+			name:   "Whitespace",
+			input:  "Microsoft.Compute/virtualMachines/ read",
+			errors: 1,
+		},
+		{
+			name:   "Consecutive wildcards",
+			input:  "Microsoft.Compute/**",
+			errors: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, errors := RoleDefinitionAction(tt.input, "actions")
+			if len(errors) != tt.errors {
+				t.Fatalf("expected %d errors but got %d: %+v", tt.errors, len(errors), errors)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/blueprint/mgmt/2018-11-01-preview/blueprint"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
@@ -144,3 +145,17 @@ func flattenArmBlueprintAssignmentResourceGroups(input map[string]*blueprint.Res
 
 	return string(b), nil
 }
+
+// validateBlueprintAssignmentLockExclusions checks that lock exclusions are only specified when the Blueprint
+// Assignment applies a lock, since they're otherwise dropped by the API
+func validateBlueprintAssignmentLockExclusions(lockMode string, excludedPrincipals, excludedActions []interface{}) error {
+	if !strings.EqualFold(lockMode, string(blueprint.AssignmentLockModeNone)) {
+		return nil
+	}
+
+	if len(excludedPrincipals) != 0 || len(excludedActions) != 0 {
+		return fmt.Errorf("`lock_exclude_principals` and `lock_exclude_actions` can only be specified when `lock_mode` is `AllResourcesReadOnly` or `AllResourcesDoNotDelete`")
+	}
+
+	return nil
+}
@@ -1,8 +1,10 @@
 package blueprints
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/blueprint/mgmt/2018-11-01-preview/blueprint"
@@ -10,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/blueprints/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/blueprints/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -29,6 +32,8 @@ func resourceBlueprintAssignment() *pluginsdk.Resource {
 		// TODO: replace this with an importer which validates the ID during import
 		Importer: pluginsdk.DefaultImporter(),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceBlueprintAssignmentCustomizeDiff),
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -80,7 +85,7 @@ func resourceBlueprintAssignment() *pluginsdk.Resource {
 			"lock_mode": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
-				Default:  string(blueprint.None),
+				Default:  string(blueprint.AssignmentLockModeNone),
 				ValidateFunc: validation.StringInSlice([]string{
 					string(blueprint.AssignmentLockModeNone),
 					string(blueprint.AssignmentLockModeAllResourcesReadOnly),
@@ -132,6 +137,22 @@ func resourceBlueprintAssignment() *pluginsdk.Resource {
 	}
 }
 
+func resourceBlueprintAssignmentCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+	if !diff.NewValueKnown("lock_mode") {
+		return nil
+	}
+
+	if err := validateBlueprintAssignmentLockExclusions(diff.Get("lock_mode").(string), diff.Get("lock_exclude_principals").([]interface{}), diff.Get("lock_exclude_actions").([]interface{})); err != nil {
+		if features.ThreePointOh() {
+			return err
+		}
+
+		log.Printf("[WARN] %+v - the exclusions will be ignored, this will become an error in version 3.0 of the Azure Provider", err)
+	}
+
+	return nil
+}
+
 func resourceBlueprintAssignmentCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Blueprints.AssignmentsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
@@ -165,7 +186,8 @@ func resourceBlueprintAssignmentCreateUpdate(d *pluginsdk.ResourceData, meta int
 		assignmentLockSettings := &blueprint.AssignmentLockSettings{}
 		lockMode := lockModeRaw.(string)
 		assignmentLockSettings.Mode = blueprint.AssignmentLockMode(lockMode)
-		if lockMode != "None" {
+		// NOTE: the exclusions are rejected during the plan in 3.0 - until then they're ignored when `lock_mode` is `None`
+		if !strings.EqualFold(lockMode, string(blueprint.AssignmentLockModeNone)) {
 			excludedPrincipalsRaw := d.Get("lock_exclude_principals").([]interface{})
 			if len(excludedPrincipalsRaw) != 0 {
 				assignmentLockSettings.ExcludedPrincipals = utils.ExpandStringSlice(excludedPrincipalsRaw)
@@ -0,0 +1,58 @@
+package blueprints
+
+import (
+	"testing"
+)
+
+func TestValidateBlueprintAssignmentLockExclusions(t *testing.T) {
+	testData := []struct {
+		name               string
+		lockMode           string
+		excludedPrincipals []interface{}
+		excludedActions    []interface{}
+		valid              bool
+	}{
+		{
+			name:     "None without exclusions",
+			lockMode: "None",
+			valid:    true,
+		},
+		{
+			name:               "None with excluded principals",
+			lockMode:           "None",
+			excludedPrincipals: []interface{}{"00000000-0000-0000-0000-000000000000"},
+			valid:              false,
+		},
+		{
+			name:            "None with excluded actions",
+			lockMode:        "None",
+			excludedActions: []interface{}{"Microsoft.Resources/subscriptions/resourceGroups/write"},
+			valid:           false,
+		},
+		{
+			name:               "AllResourcesReadOnly with exclusions",
+			lockMode:           "AllResourcesReadOnly",
+			excludedPrincipals: []interface{}{"00000000-0000-0000-0000-000000000000"},
+			excludedActions:    []interface{}{"Microsoft.Resources/subscriptions/resourceGroups/write"},
+			valid:              true,
+		},
+		{
+			name:            "AllResourcesDoNotDelete with excluded actions",
+			lockMode:        "AllResourcesDoNotDelete",
+			excludedActions: []interface{}{"Microsoft.Resources/subscriptions/resourceGroups/write"},
+			valid:           true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		err := validateBlueprintAssignmentLockExclusions(v.lockMode, v.excludedPrincipals, v.excludedActions)
+		if v.valid && err != nil {
+			t.Fatalf("expected %q to be valid but got: %+v", v.name, err)
+		}
+		if !v.valid && err == nil {
+			t.Fatalf("expected %q to be invalid but it was valid", v.name)
+		}
+	}
+}
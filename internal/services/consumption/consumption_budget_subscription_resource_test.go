@@ -116,6 +116,25 @@ func TestAccConsumptionBudgetSubscription_completeUpdate(t *testing.T) {
 	})
 }
 
+func TestAccConsumptionBudgetSubscription_forecasted(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_consumption_budget_subscription", "test")
+	r := ConsumptionBudgetSubscriptionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.forecasted(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("notification.#").HasValue("1"),
+				check.That(data.ResourceName).Key("notification.0.threshold_type").HasValue("Forecasted"),
+				check.That(data.ResourceName).Key("filter.0.dimension.#").HasValue("1"),
+				check.That(data.ResourceName).Key("filter.0.tag.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (ConsumptionBudgetSubscriptionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.ConsumptionBudgetID(state.ID)
 	if err != nil {
@@ -172,6 +191,63 @@ resource "azurerm_consumption_budget_subscription" "test" {
 `, data.RandomInteger, consumptionBudgetTestStartDate().Format(time.RFC3339))
 }
 
+func (ConsumptionBudgetSubscriptionResource) forecasted(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_subscription" "test" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-consumption-%d"
+  location = "%s"
+}
+
+resource "azurerm_consumption_budget_subscription" "test" {
+  name            = "acctestconsumptionbudgetsubscription-%d"
+  subscription_id = data.azurerm_subscription.test.id
+
+  amount     = 1000
+  time_grain = "Monthly"
+
+  time_period {
+    start_date = "%s"
+  }
+
+  filter {
+    dimension {
+      name     = "ResourceGroupName"
+      operator = "In"
+      values = [
+        azurerm_resource_group.test.name,
+      ]
+    }
+
+    tag {
+      name     = "foo"
+      operator = "In"
+      values = [
+        "bar",
+        "baz",
+      ]
+    }
+  }
+
+  notification {
+    enabled        = true
+    threshold      = 110
+    threshold_type = "Forecasted"
+    operator       = "GreaterThan"
+
+    contact_emails = [
+      "foo@example.com",
+    ]
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, consumptionBudgetTestStartDate().Format(time.RFC3339))
+}
+
 func (ConsumptionBudgetSubscriptionResource) basicUpdate(data acceptance.TestData) string {
 	subscriptionIdKey := "id"
 	if !features.ThreePointOhBeta() {
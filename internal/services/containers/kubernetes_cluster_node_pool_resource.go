@@ -1,6 +1,7 @@
 package containers
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -50,6 +51,8 @@ func resourceKubernetesClusterNodePool() *pluginsdk.Resource {
 			0: migration.KubernetesClusterNodePoolV0ToV1{},
 		}),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceKubernetesClusterNodePoolCustomizeDiff),
+
 		Schema: func() map[string]*pluginsdk.Schema {
 			s := map[string]*pluginsdk.Schema{
 				"name": {
@@ -110,6 +113,19 @@ func resourceKubernetesClusterNodePool() *pluginsdk.Resource {
 					}, false),
 				},
 
+				"gpu_instance": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+					ForceNew: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(containerservice.GPUInstanceProfileMIG1g),
+						string(containerservice.GPUInstanceProfileMIG2g),
+						string(containerservice.GPUInstanceProfileMIG3g),
+						string(containerservice.GPUInstanceProfileMIG4g),
+						string(containerservice.GPUInstanceProfileMIG7g),
+					}, false),
+				},
+
 				"kubelet_config": schemaNodePoolKubeletConfig(),
 
 				"linux_os_config": schemaNodePoolLinuxOSConfig(),
@@ -323,6 +339,39 @@ func resourceKubernetesClusterNodePool() *pluginsdk.Resource {
 	}
 }
 
+func resourceKubernetesClusterNodePoolCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+	// Multi-Instance GPU profiles can only be used with GPU enabled VM Sizes, which are all within the N-series
+	if gpuInstance := d.Get("gpu_instance").(string); gpuInstance != "" && d.NewValueKnown("vm_size") {
+		vmSize := d.Get("vm_size").(string)
+		if !strings.HasPrefix(strings.ToLower(vmSize), "standard_n") {
+			return fmt.Errorf("`gpu_instance` can only be specified when `vm_size` is a GPU enabled VM Size (N-series) but got %q", vmSize)
+		}
+	}
+
+	// when using dynamic IP allocation both the Pods and the Nodes must be placed into (separate) Subnets
+	if d.NewValueKnown("pod_subnet_id") && d.NewValueKnown("vnet_subnet_id") {
+		podSubnetId := d.Get("pod_subnet_id").(string)
+		vnetSubnetId := d.Get("vnet_subnet_id").(string)
+		if podSubnetId != "" {
+			if vnetSubnetId == "" {
+				return fmt.Errorf("`vnet_subnet_id` must be specified when `pod_subnet_id` is set")
+			}
+			if strings.EqualFold(podSubnetId, vnetSubnetId) {
+				return fmt.Errorf("`pod_subnet_id` and `vnet_subnet_id` must reference different Subnets")
+			}
+		}
+	}
+
+	// the available OS SKUs are all Linux distributions
+	if osSku := d.Get("os_sku").(string); osSku != "" && (d.Id() == "" || d.HasChange("os_sku")) {
+		if osType := d.Get("os_type").(string); osType != string(containerservice.OSTypeLinux) {
+			return fmt.Errorf("`os_sku` can only be specified when `os_type` is set to %q but got %q", string(containerservice.OSTypeLinux), osType)
+		}
+	}
+
+	return nil
+}
+
 func resourceKubernetesClusterNodePoolCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	containersClient := meta.(*clients.Client).Containers
 	clustersClient := containersClient.KubernetesClustersClient
@@ -406,6 +455,10 @@ func resourceKubernetesClusterNodePoolCreate(d *pluginsdk.ResourceData, meta int
 		profile.OsSKU = containerservice.OSSKU(osSku)
 	}
 
+	if gpuInstance := d.Get("gpu_instance").(string); gpuInstance != "" {
+		profile.GpuInstanceProfile = containerservice.GPUInstanceProfile(gpuInstance)
+	}
+
 	if scaleDownMode := d.Get("scale_down_mode").(string); scaleDownMode != "" {
 		profile.ScaleDownMode = containerservice.ScaleDownMode(scaleDownMode)
 	}
@@ -745,6 +798,7 @@ func resourceKubernetesClusterNodePoolRead(d *pluginsdk.ResourceData, meta inter
 		d.Set("enable_node_public_ip", props.EnableNodePublicIP)
 		d.Set("enable_host_encryption", props.EnableEncryptionAtHost)
 		d.Set("fips_enabled", props.EnableFIPS)
+		d.Set("gpu_instance", string(props.GpuInstanceProfile))
 		d.Set("ultra_ssd_enabled", props.EnableUltraSSD)
 		d.Set("kubelet_disk_type", string(props.KubeletDiskType))
 		scaleDownMode := string(containerservice.ScaleDownModeDelete)
@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
 	containerValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	logAnalyticsValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/validate"
 	msiparse "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/parse"
 	msivalidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/validate"
 	privateDnsValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/validate"
@@ -441,6 +442,21 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 				},
 			},
 
+			"microsoft_defender": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"log_analytics_workspace_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: logAnalyticsValidate.LogAnalyticsWorkspaceID,
+						},
+					},
+				},
+			},
+
 			"network_profile": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -1198,6 +1214,11 @@ func resourceKubernetesClusterCreate(d *pluginsdk.ResourceData, meta interface{}
 	httpProxyConfigRaw := d.Get("http_proxy_config").([]interface{})
 	httpProxyConfig := expandKubernetesClusterHttpProxyConfig(httpProxyConfigRaw)
 
+	var securityProfile *containerservice.ManagedClusterSecurityProfile
+	if microsoftDefenderRaw := d.Get("microsoft_defender").([]interface{}); len(microsoftDefenderRaw) > 0 {
+		securityProfile = expandKubernetesClusterMicrosoftDefender(microsoftDefenderRaw)
+	}
+
 	publicNetworkAccess := containerservice.PublicNetworkAccessEnabled
 	if !d.Get("public_network_access_enabled").(bool) {
 		publicNetworkAccess = containerservice.PublicNetworkAccessDisabled
@@ -1226,6 +1247,7 @@ func resourceKubernetesClusterCreate(d *pluginsdk.ResourceData, meta interface{}
 			PublicNetworkAccess:    publicNetworkAccess,
 			DisableLocalAccounts:   utils.Bool(d.Get("local_account_disabled").(bool)),
 			HTTPProxyConfig:        httpProxyConfig,
+			SecurityProfile:        securityProfile,
 		},
 		Tags: tags.Expand(t),
 	}
@@ -1662,6 +1684,12 @@ func resourceKubernetesClusterUpdate(d *pluginsdk.ResourceData, meta interface{}
 		existing.ManagedClusterProperties.HTTPProxyConfig = httpProxyConfig
 	}
 
+	if d.HasChange("microsoft_defender") {
+		updateCluster = true
+		microsoftDefenderRaw := d.Get("microsoft_defender").([]interface{})
+		existing.ManagedClusterProperties.SecurityProfile = expandKubernetesClusterMicrosoftDefender(microsoftDefenderRaw)
+	}
+
 	if updateCluster {
 		log.Printf("[DEBUG] Updating %s..", *id)
 		future, err := clusterClient.CreateOrUpdate(ctx, id.ResourceGroup, id.ManagedClusterName, existing)
@@ -1894,6 +1922,11 @@ func resourceKubernetesClusterRead(d *pluginsdk.ResourceData, meta interface{})
 			return fmt.Errorf("setting `http_proxy_config`: %+v", err)
 		}
 
+		microsoftDefender := flattenKubernetesClusterMicrosoftDefender(props.SecurityProfile)
+		if err := d.Set("microsoft_defender", microsoftDefender); err != nil {
+			return fmt.Errorf("setting `microsoft_defender`: %+v", err)
+		}
+
 		// adminProfile is only available for RBAC enabled clusters with AAD and local account is not disabled
 		if props.AadProfile != nil && (props.DisableLocalAccounts == nil || !*props.DisableLocalAccounts) {
 			adminProfile, err := client.GetAccessProfile(ctx, id.ResourceGroup, id.ManagedClusterName, "clusterAdmin")
@@ -3038,3 +3071,39 @@ func flattenKubernetesClusterHttpProxyConfig(props *containerservice.ManagedClus
 		"trusted_ca":  trustedCa,
 	})
 }
+
+func expandKubernetesClusterMicrosoftDefender(input []interface{}) *containerservice.ManagedClusterSecurityProfile {
+	// Microsoft Defender has to be explicitly disabled when the block is removed
+	if len(input) == 0 || input[0] == nil {
+		return &containerservice.ManagedClusterSecurityProfile{
+			AzureDefender: &containerservice.ManagedClusterSecurityProfileAzureDefender{
+				Enabled: utils.Bool(false),
+			},
+		}
+	}
+
+	config := input[0].(map[string]interface{})
+	return &containerservice.ManagedClusterSecurityProfile{
+		AzureDefender: &containerservice.ManagedClusterSecurityProfileAzureDefender{
+			Enabled:                         utils.Bool(true),
+			LogAnalyticsWorkspaceResourceID: utils.String(config["log_analytics_workspace_id"].(string)),
+		},
+	}
+}
+
+func flattenKubernetesClusterMicrosoftDefender(input *containerservice.ManagedClusterSecurityProfile) []interface{} {
+	if input == nil || input.AzureDefender == nil || input.AzureDefender.Enabled == nil || !*input.AzureDefender.Enabled {
+		return []interface{}{}
+	}
+
+	logAnalyticsWorkspaceId := ""
+	if v := input.AzureDefender.LogAnalyticsWorkspaceResourceID; v != nil {
+		logAnalyticsWorkspaceId = *v
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"log_analytics_workspace_id": logAnalyticsWorkspaceId,
+		},
+	}
+}
@@ -1,6 +1,7 @@
 package costmanagement
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -35,6 +36,8 @@ func resourceCostManagementExportResourceGroup() *pluginsdk.Resource {
 			return strings.ReplaceAll(msg, "'", "`")
 		}(),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceCostManagementExportResourceGroupCustomizeDiff),
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
@@ -145,6 +148,10 @@ func resourceCostManagementExportResourceGroup() *pluginsdk.Resource {
 	}
 }
 
+func resourceCostManagementExportResourceGroupCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+	return validateCostManagementExportRecurrencePeriod(diff.Get("recurrence_period_start").(string), diff.Get("recurrence_period_end").(string))
+}
+
 func resourceCostManagementExportResourceGroupCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).CostManagement.ExportClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
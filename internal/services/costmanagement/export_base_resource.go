@@ -8,6 +8,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/costmanagement/mgmt/2020-06-01/costmanagement"
 	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/costmanagement/parse"
 	storageParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
@@ -89,16 +90,9 @@ func (br costManagementExportBaseResource) arguments(fields map[string]*pluginsd
 					},
 
 					"time_frame": {
-						Type:     pluginsdk.TypeString,
-						Required: true,
-						ValidateFunc: validation.StringInSlice([]string{
-							string(costmanagement.Custom),
-							string(costmanagement.BillingMonthToDate),
-							string(costmanagement.TheLastBillingMonth),
-							string(costmanagement.TheLastMonth),
-							string(costmanagement.WeekToDate),
-							string(costmanagement.MonthToDate),
-						}, false),
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(costManagementExportTimeFrames(), false),
 					},
 				},
 			},
@@ -116,6 +110,55 @@ func (br costManagementExportBaseResource) attributes() map[string]*pluginsdk.Sc
 	return map[string]*pluginsdk.Schema{}
 }
 
+func (br costManagementExportBaseResource) customizeDiffFunc() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			rd := metadata.ResourceDiff
+			return validateCostManagementExportRecurrencePeriod(rd.Get("recurrence_period_start_date").(string), rd.Get("recurrence_period_end_date").(string))
+		},
+	}
+}
+
+func costManagementExportTimeFrames() []string {
+	timeFrames := []string{
+		string(costmanagement.BillingMonthToDate),
+		string(costmanagement.TheLastBillingMonth),
+		string(costmanagement.TheLastMonth),
+		string(costmanagement.WeekToDate),
+		string(costmanagement.MonthToDate),
+	}
+
+	// a `Custom` time frame requires an explicit time period, which can't be specified for a scheduled export
+	if !features.ThreePointOh() {
+		timeFrames = append(timeFrames, string(costmanagement.Custom))
+	}
+
+	return timeFrames
+}
+
+// validateCostManagementExportRecurrencePeriod checks that the recurrence period of an export ends after it starts.
+// Either date may be empty when it's not yet known, in which case the check is skipped.
+func validateCostManagementExportRecurrencePeriod(startDate, endDate string) error {
+	if startDate == "" || endDate == "" {
+		return nil
+	}
+
+	start, err := time.Parse(time.RFC3339, startDate)
+	if err != nil {
+		return fmt.Errorf("parsing the recurrence period start date %q: %+v", startDate, err)
+	}
+	end, err := time.Parse(time.RFC3339, endDate)
+	if err != nil {
+		return fmt.Errorf("parsing the recurrence period end date %q: %+v", endDate, err)
+	}
+	if !end.After(start) {
+		return fmt.Errorf("the recurrence period end date (%s) must be after the start date (%s)", endDate, startDate)
+	}
+
+	return nil
+}
+
 func (br costManagementExportBaseResource) createFunc(resourceName, scopeFieldName string) sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
@@ -0,0 +1,55 @@
+package costmanagement
+
+import (
+	"testing"
+)
+
+func TestValidateCostManagementExportRecurrencePeriod(t *testing.T) {
+	testData := []struct {
+		name      string
+		startDate string
+		endDate   string
+		valid     bool
+	}{
+		{
+			name:      "End date after start date",
+			startDate: "2022-01-01T00:00:00Z",
+			endDate:   "2023-01-01T00:00:00Z",
+			valid:     true,
+		},
+		{
+			name:    "Unknown start date",
+			endDate: "2023-01-01T00:00:00Z",
+			valid:   true,
+		},
+		{
+			name:      "Unknown end date",
+			startDate: "2022-01-01T00:00:00Z",
+			valid:     true,
+		},
+		{
+			name:      "End date before start date",
+			startDate: "2023-01-01T00:00:00Z",
+			endDate:   "2022-01-01T00:00:00Z",
+			valid:     false,
+		},
+		{
+			name:      "End date equal to start date",
+			startDate: "2022-01-01T00:00:00Z",
+			endDate:   "2022-01-01T00:00:00Z",
+			valid:     false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		err := validateCostManagementExportRecurrencePeriod(v.startDate, v.endDate)
+		if v.valid && err != nil {
+			t.Fatalf("expected %q to be valid but got: %+v", v.name, err)
+		}
+		if !v.valid && err == nil {
+			t.Fatalf("expected %q to be invalid but it was valid", v.name)
+		}
+	}
+}
@@ -12,7 +12,7 @@ type ResourceGroupCostManagementExportResource struct {
 	base costManagementExportBaseResource
 }
 
-var _ sdk.Resource = ResourceGroupCostManagementExportResource{}
+var _ sdk.ResourceWithCustomizeDiff = ResourceGroupCostManagementExportResource{}
 
 func (r ResourceGroupCostManagementExportResource) Arguments() map[string]*pluginsdk.Schema {
 	schema := map[string]*pluginsdk.Schema{
@@ -63,3 +63,7 @@ func (r ResourceGroupCostManagementExportResource) Delete() sdk.ResourceFunc {
 func (r ResourceGroupCostManagementExportResource) Update() sdk.ResourceFunc {
 	return r.base.updateFunc()
 }
+
+func (r ResourceGroupCostManagementExportResource) CustomizeDiff() sdk.ResourceFunc {
+	return r.base.customizeDiffFunc()
+}
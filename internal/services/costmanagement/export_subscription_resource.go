@@ -12,7 +12,7 @@ type SubscriptionCostManagementExportResource struct {
 	base costManagementExportBaseResource
 }
 
-var _ sdk.Resource = SubscriptionCostManagementExportResource{}
+var _ sdk.ResourceWithCustomizeDiff = SubscriptionCostManagementExportResource{}
 
 func (r SubscriptionCostManagementExportResource) Arguments() map[string]*pluginsdk.Schema {
 	schema := map[string]*pluginsdk.Schema{
@@ -63,3 +63,7 @@ func (r SubscriptionCostManagementExportResource) Delete() sdk.ResourceFunc {
 func (r SubscriptionCostManagementExportResource) Update() sdk.ResourceFunc {
 	return r.base.updateFunc()
 }
+
+func (r SubscriptionCostManagementExportResource) CustomizeDiff() sdk.ResourceFunc {
+	return r.base.customizeDiffFunc()
+}
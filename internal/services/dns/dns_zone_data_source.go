@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/dns/mgmt/2018-05-01/dns"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
@@ -28,11 +29,7 @@ func dataSourceDnsZone() *pluginsdk.Resource {
 				Required: true,
 			},
 
-			"resource_group_name": {
-				Type:     pluginsdk.TypeString,
-				Optional: true,
-				Computed: true,
-			},
+			"resource_group_name": azure.SchemaResourceGroupNameOptional(),
 
 			"number_of_record_sets": {
 				Type:     pluginsdk.TypeInt,
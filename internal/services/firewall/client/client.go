@@ -9,6 +9,8 @@ type Client struct {
 	AzureFirewallsClient          *network.AzureFirewallsClient
 	FirewallPolicyClient          *network.FirewallPoliciesClient
 	FirewallPolicyRuleGroupClient *network.FirewallPolicyRuleCollectionGroupsClient
+
+	options *common.ClientOptions
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -25,5 +27,13 @@ func NewClient(o *common.ClientOptions) *Client {
 		AzureFirewallsClient:          &firewallsClient,
 		FirewallPolicyClient:          &policyClient,
 		FirewallPolicyRuleGroupClient: &policyRuleGroupClient,
+
+		options: o,
 	}
 }
+
+func (c Client) FirewallPolicyClientForSubscription(subscriptionID string) *network.FirewallPoliciesClient {
+	policyClient := network.NewFirewallPoliciesClientWithBaseURI(c.options.ResourceManagerEndpoint, subscriptionID)
+	c.options.ConfigureClient(&policyClient.Client, c.options.ResourceManagerAuthorizer)
+	return &policyClient
+}
@@ -986,6 +986,7 @@ func resourceFirewallPolicySchema() map[string]*pluginsdk.Schema {
 				ValidateFunc: validation.Any(
 					validation.IsCIDR,
 					validation.IsIPv4Address,
+					validation.StringInSlice([]string{"IANAPrivateRanges"}, false),
 				),
 			},
 		},
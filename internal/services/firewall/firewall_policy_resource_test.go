@@ -193,6 +193,30 @@ func TestAccFirewallPolicy_insights(t *testing.T) {
 	})
 }
 
+func TestAccFirewallPolicy_privateIpRanges(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy", "test")
+	r := FirewallPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.privateIpRanges(data, `["IANAPrivateRanges"]`),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("private_ip_ranges.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.privateIpRanges(data, `["IANAPrivateRanges", "100.64.0.0/10", "203.0.113.10"]`),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("private_ip_ranges.#").HasValue("3"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (FirewallPolicyResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.FirewallPolicyID(state.ID)
 	if err != nil {
@@ -220,6 +244,20 @@ resource "azurerm_firewall_policy" "test" {
 `, template, data.RandomInteger)
 }
 
+func (FirewallPolicyResource) privateIpRanges(data acceptance.TestData, privateIpRanges string) string {
+	r := FirewallPolicyResource{}
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+resource "azurerm_firewall_policy" "test" {
+  name                = "acctest-networkfw-Policy-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  private_ip_ranges   = %s
+}
+`, template, data.RandomInteger, privateIpRanges)
+}
+
 func (FirewallPolicyResource) basicPremium(data acceptance.TestData) string {
 	r := FirewallPolicyResource{}
 	template := r.template(data)
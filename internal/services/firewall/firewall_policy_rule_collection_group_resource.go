@@ -1,6 +1,7 @@
 package firewall
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
@@ -13,6 +14,7 @@ import (
 	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	firewallClient "github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/client"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -39,6 +41,8 @@ func resourceFirewallPolicyRuleCollectionGroup() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceFirewallPolicyRuleCollectionGroupCustomizeDiff),
+
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:         pluginsdk.TypeString,
@@ -417,6 +421,86 @@ func resourceFirewallPolicyRuleCollectionGroup() *pluginsdk.Resource {
 	}
 }
 
+func resourceFirewallPolicyRuleCollectionGroupCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+	// the priority of each Rule Collection must be unique within the Rule Collection Group, regardless of its type
+	priorities := make(map[int]string)
+	for _, collectionType := range []string{"application_rule_collection", "network_rule_collection", "nat_rule_collection"} {
+		if !d.NewValueKnown(collectionType) {
+			continue
+		}
+
+		for _, raw := range d.Get(collectionType).(*pluginsdk.Set).List() {
+			collection := raw.(map[string]interface{})
+			name := collection["name"].(string)
+			priority := collection["priority"].(int)
+
+			// the priority won't be known yet if it's interpolated from another resource
+			if priority == 0 {
+				continue
+			}
+
+			if existing, ok := priorities[priority]; ok {
+				return fmt.Errorf("the Rule Collections %q and %q both have the priority %d - the priority of each Rule Collection must be unique within a Rule Collection Group", existing, name, priority)
+			}
+			priorities[priority] = name
+		}
+	}
+
+	return nil
+}
+
+func firewallPolicyRuleCollectionNetworkUsesFqdns(input []interface{}) bool {
+	for _, raw := range input {
+		collection := raw.(map[string]interface{})
+		for _, ruleRaw := range collection["rule"].(*pluginsdk.Set).List() {
+			rule := ruleRaw.(map[string]interface{})
+			if rule["destination_fqdns"].(*pluginsdk.Set).Len() > 0 {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// validateFirewallPolicyDNSProxyEnabled ensures that DNS Proxy is enabled on the Firewall Policy (or the Base Policy
+// it inherits from), since this is required to be able to use FQDNs within Network Rules
+func validateFirewallPolicyDNSProxyEnabled(ctx context.Context, client *firewallClient.Client, id parse.FirewallPolicyId) error {
+	policyId := id
+	for {
+		// the Base Policy can be defined in a different Subscription to the Firewall Policy
+		resp, err := client.FirewallPolicyClientForSubscription(policyId.SubscriptionId).Get(ctx, policyId.ResourceGroup, policyId.Name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				// leave this to the API
+				return nil
+			}
+			return fmt.Errorf("retrieving %s: %+v", policyId, err)
+		}
+
+		props := resp.FirewallPolicyPropertiesFormat
+		if props == nil {
+			return fmt.Errorf("retrieving %s: `properties` was nil", policyId)
+		}
+
+		if props.DNSSettings != nil && props.DNSSettings.EnableProxy != nil && *props.DNSSettings.EnableProxy {
+			return nil
+		}
+
+		if props.BasePolicy == nil || props.BasePolicy.ID == nil {
+			break
+		}
+
+		basePolicyId, err := parse.FirewallPolicyID(*props.BasePolicy.ID)
+		if err != nil {
+			return err
+		}
+		policyId = *basePolicyId
+	}
+
+	return fmt.Errorf("`destination_fqdns` can only be used within a `network_rule_collection` when `proxy_enabled` is set to `true` within the `dns` block of %s", id)
+}
+
 func resourceFirewallPolicyRuleCollectionGroupCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Firewall.FirewallPolicyRuleGroupClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
@@ -441,6 +525,13 @@ func resourceFirewallPolicyRuleCollectionGroupCreateUpdate(d *pluginsdk.Resource
 		}
 	}
 
+	// this is checked here rather than during the plan, since DNS Proxy can be enabled on the Firewall Policy in the same apply
+	if firewallPolicyRuleCollectionNetworkUsesFqdns(d.Get("network_rule_collection").(*pluginsdk.Set).List()) {
+		if err := validateFirewallPolicyDNSProxyEnabled(ctx, meta.(*clients.Client).Firewall, *policyId); err != nil {
+			return err
+		}
+	}
+
 	locks.ByName(policyId.Name, azureFirewallPolicyResourceName)
 	defer locks.UnlockByName(policyId.Name, azureFirewallPolicyResourceName)
 
@@ -1,6 +1,7 @@
 package frontdoor
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -11,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/frontdoor/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/frontdoor/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/frontdoor/sdk/2020-04-01/webapplicationfirewallpolicies"
@@ -38,6 +40,8 @@ func resourceFrontDoorFirewallPolicy() *pluginsdk.Resource {
 			return err
 		}),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceFrontDoorFirewallPolicyCustomizeDiff),
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
@@ -430,6 +434,43 @@ func resourceFrontDoorFirewallPolicy() *pluginsdk.Resource {
 	}
 }
 
+func resourceFrontDoorFirewallPolicyCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, meta interface{}) error {
+	if !diff.HasChange("custom_rule") {
+		return nil
+	}
+
+	for i, item := range diff.Get("custom_rule").([]interface{}) {
+		// the rate limit is ignored by the API for a `MatchRule`, so is only checked for a `RateLimitRule`
+		typeKey := fmt.Sprintf("custom_rule.%d.type", i)
+		durationKey := fmt.Sprintf("custom_rule.%d.rate_limit_duration_in_minutes", i)
+		thresholdKey := fmt.Sprintf("custom_rule.%d.rate_limit_threshold", i)
+		if !diff.NewValueKnown(typeKey) || !diff.NewValueKnown(durationKey) || !diff.NewValueKnown(thresholdKey) {
+			continue
+		}
+
+		v := item.(map[string]interface{})
+		if v["type"].(string) != string(webapplicationfirewallpolicies.RuleTypeRateLimitRule) {
+			continue
+		}
+
+		var err error
+		if duration := v["rate_limit_duration_in_minutes"].(int); duration != 1 && duration != 5 {
+			err = fmt.Errorf("`rate_limit_duration_in_minutes` must be `1` or `5` for the `RateLimitRule` %q, got %d", v["name"].(string), duration)
+		} else if threshold := v["rate_limit_threshold"].(int); threshold < 1 {
+			err = fmt.Errorf("`rate_limit_threshold` must be at least `1` for the `RateLimitRule` %q, got %d", v["name"].(string), threshold)
+		}
+
+		if err != nil {
+			if features.ThreePointOh() {
+				return err
+			}
+			log.Printf("[WARN] %+v - this will become an error in version 3.0 of the provider", err)
+		}
+	}
+
+	return nil
+}
+
 func resourceFrontDoorFirewallPolicyCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Frontdoor.FrontDoorsPolicyClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
@@ -887,8 +928,8 @@ func flattenFrontDoorFirewallExclusions(managedRuleExclusion *[]webapplicationfi
 	for _, o := range *managedRuleExclusion {
 		output := make(map[string]interface{})
 
-		output["match_variable"] = o.MatchVariable
-		output["operator"] = o.SelectorMatchOperator
+		output["match_variable"] = string(o.MatchVariable)
+		output["operator"] = string(o.SelectorMatchOperator)
 		output["selector"] = o.Selector
 
 		results = append(results, output)
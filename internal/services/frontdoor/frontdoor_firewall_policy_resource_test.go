@@ -106,6 +106,44 @@ func TestAccFrontDoorFirewallPolicy_complete(t *testing.T) {
 	})
 }
 
+func TestAccFrontDoorFirewallPolicy_rateLimitRule(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_frontdoor_firewall_policy", "test")
+	r := FrontDoorFirewallPolicyResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.rateLimitRule(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("custom_rule.0.type").HasValue("RateLimitRule"),
+				check.That(data.ResourceName).Key("custom_rule.0.rate_limit_duration_in_minutes").HasValue("5"),
+				check.That(data.ResourceName).Key("custom_rule.0.rate_limit_threshold").HasValue("100"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccFrontDoorFirewallPolicy_managedRuleExclusions(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_frontdoor_firewall_policy", "test")
+	r := FrontDoorFirewallPolicyResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.managedRuleExclusions(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("managed_rule.0.exclusion.#").HasValue("2"),
+				check.That(data.ResourceName).Key("managed_rule.0.exclusion.1.match_variable").HasValue("RequestCookieNames"),
+				check.That(data.ResourceName).Key("managed_rule.0.exclusion.1.operator").HasValue("StartsWith"),
+				check.That(data.ResourceName).Key("managed_rule.0.override.0.exclusion.0.match_variable").HasValue("RequestHeaderNames"),
+				check.That(data.ResourceName).Key("managed_rule.0.override.0.exclusion.0.selector").HasValue("x-company-secret"),
+				check.That(data.ResourceName).Key("managed_rule.0.override.0.rule.0.exclusion.0.match_variable").HasValue("RequestBodyPostArgNames"),
+				check.That(data.ResourceName).Key("managed_rule.0.override.0.rule.0.exclusion.0.operator").HasValue("Contains"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (FrontDoorFirewallPolicyResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := webapplicationfirewallpolicies.ParseFrontDoorWebApplicationFirewallPoliciesIDInsensitively(state.ID)
 	if err != nil {
@@ -149,6 +187,99 @@ resource "azurerm_frontdoor_firewall_policy" "import" {
 `, r.basic(data))
 }
 
+func (FrontDoorFirewallPolicyResource) rateLimitRule(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "testaccRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_frontdoor_firewall_policy" "test" {
+  name                = "testAccFrontDoorWAF%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  mode                = "Prevention"
+
+  custom_rule {
+    name                           = "RateLimitRule1"
+    enabled                        = true
+    priority                       = 1
+    type                           = "RateLimitRule"
+    rate_limit_duration_in_minutes = 5
+    rate_limit_threshold           = 100
+    action                         = "Block"
+
+    match_condition {
+      match_variable     = "RemoteAddr"
+      operator           = "IPMatch"
+      negation_condition = false
+      match_values       = ["10.0.0.0/8"]
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (FrontDoorFirewallPolicyResource) managedRuleExclusions(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "testaccRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_frontdoor_firewall_policy" "test" {
+  name                = "testAccFrontDoorWAF%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  mode                = "Prevention"
+
+  managed_rule {
+    type    = "DefaultRuleSet"
+    version = "1.0"
+
+    exclusion {
+      match_variable = "QueryStringArgNames"
+      operator       = "EqualsAny"
+      selector       = "*"
+    }
+
+    exclusion {
+      match_variable = "RequestCookieNames"
+      operator       = "StartsWith"
+      selector       = "session"
+    }
+
+    override {
+      rule_group_name = "SQLI"
+
+      exclusion {
+        match_variable = "RequestHeaderNames"
+        operator       = "Equals"
+        selector       = "x-company-secret"
+      }
+
+      rule {
+        rule_id = "942200"
+        action  = "Block"
+
+        exclusion {
+          match_variable = "RequestBodyPostArgNames"
+          operator       = "Contains"
+          selector       = "comment"
+        }
+      }
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
 func (r FrontDoorFirewallPolicyResource) update(data acceptance.TestData, update bool) string {
 	if update {
 		return r.updated(data)
@@ -1,6 +1,7 @@
 package kusto
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -36,6 +37,8 @@ func resourceKustoAttachedDatabaseConfiguration() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
 		},
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceKustoAttachedDatabaseConfigurationCustomizeDiff),
+
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:         pluginsdk.TypeString,
@@ -98,7 +101,8 @@ func resourceKustoAttachedDatabaseConfiguration() *pluginsdk.Resource {
 							Type:     pluginsdk.TypeSet,
 							Optional: true,
 							Elem: &pluginsdk.Schema{
-								Type: pluginsdk.TypeString,
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
 							},
 						},
 
@@ -106,7 +110,8 @@ func resourceKustoAttachedDatabaseConfiguration() *pluginsdk.Resource {
 							Type:     pluginsdk.TypeSet,
 							Optional: true,
 							Elem: &pluginsdk.Schema{
-								Type: pluginsdk.TypeString,
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
 							},
 						},
 
@@ -114,7 +119,8 @@ func resourceKustoAttachedDatabaseConfiguration() *pluginsdk.Resource {
 							Type:     pluginsdk.TypeSet,
 							Optional: true,
 							Elem: &pluginsdk.Schema{
-								Type: pluginsdk.TypeString,
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
 							},
 						},
 
@@ -122,7 +128,8 @@ func resourceKustoAttachedDatabaseConfiguration() *pluginsdk.Resource {
 							Type:     pluginsdk.TypeSet,
 							Optional: true,
 							Elem: &pluginsdk.Schema{
-								Type: pluginsdk.TypeString,
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
 							},
 						},
 
@@ -130,7 +137,8 @@ func resourceKustoAttachedDatabaseConfiguration() *pluginsdk.Resource {
 							Type:     pluginsdk.TypeSet,
 							Optional: true,
 							Elem: &pluginsdk.Schema{
-								Type: pluginsdk.TypeString,
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
 							},
 						},
 
@@ -138,7 +146,8 @@ func resourceKustoAttachedDatabaseConfiguration() *pluginsdk.Resource {
 							Type:     pluginsdk.TypeSet,
 							Optional: true,
 							Elem: &pluginsdk.Schema{
-								Type: pluginsdk.TypeString,
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
 							},
 						},
 					},
@@ -265,6 +274,34 @@ func expandKustoAttachedDatabaseConfigurationProperties(d *pluginsdk.ResourceDat
 	return AttachedDatabaseConfigurationProperties
 }
 
+func resourceKustoAttachedDatabaseConfigurationCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+	if !d.NewValueKnown("sharing") {
+		return nil
+	}
+
+	sharing := d.Get("sharing").([]interface{})
+	if len(sharing) == 0 || sharing[0] == nil {
+		return nil
+	}
+	sharingProperties := sharing[0].(map[string]interface{})
+
+	// the same table can't be both included in and excluded from the follower database
+	for _, kind := range []string{"tables", "external_tables", "materialized_views"} {
+		excluded := make(map[string]bool)
+		for _, item := range sharingProperties[kind+"_to_exclude"].(*pluginsdk.Set).List() {
+			excluded[item.(string)] = true
+		}
+
+		for _, item := range sharingProperties[kind+"_to_include"].(*pluginsdk.Set).List() {
+			if excluded[item.(string)] {
+				return fmt.Errorf("%q cannot be specified in both `sharing.0.%s_to_include` and `sharing.0.%s_to_exclude`", item.(string), kind, kind)
+			}
+		}
+	}
+
+	return nil
+}
+
 func expandAttachedDatabaseConfigurationTableLevelSharingProperties(input []interface{}) *kusto.TableLevelSharingProperties {
 	if len(input) == 0 {
 		return nil
@@ -3,6 +3,7 @@ package kusto_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
@@ -30,6 +31,18 @@ func TestAccKustoAttachedDatabaseConfiguration_basic(t *testing.T) {
 	})
 }
 
+func TestAccKustoAttachedDatabaseConfiguration_sharingIncludedAndExcluded(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kusto_attached_database_configuration", "test")
+	r := KustoAttachedDatabaseConfigurationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.sharingIncludedAndExcluded(data),
+			ExpectError: regexp.MustCompile("cannot be specified in both `sharing.0.tables_to_include` and `sharing.0.tables_to_exclude`"),
+		},
+	})
+}
+
 func (KustoAttachedDatabaseConfigurationResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.AttachedDatabaseConfigurationID(state.ID)
 	if err != nil {
@@ -110,3 +123,70 @@ resource "azurerm_kusto_attached_database_configuration" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomString, data.RandomString, data.RandomInteger, data.RandomInteger, data.RandomInteger)
 }
+
+func (KustoAttachedDatabaseConfigurationResource) sharingIncludedAndExcluded(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "rg" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_kusto_cluster" "cluster1" {
+  name                = "acctestkc1%s"
+  location            = azurerm_resource_group.rg.location
+  resource_group_name = azurerm_resource_group.rg.name
+
+  sku {
+    name     = "Dev(No SLA)_Standard_D11_v2"
+    capacity = 1
+  }
+}
+
+resource "azurerm_kusto_cluster" "cluster2" {
+  name                = "acctestkc2%s"
+  location            = azurerm_resource_group.rg.location
+  resource_group_name = azurerm_resource_group.rg.name
+
+  sku {
+    name     = "Dev(No SLA)_Standard_D11_v2"
+    capacity = 1
+  }
+}
+
+resource "azurerm_kusto_database" "followed_database" {
+  name                = "acctestkd-%d"
+  resource_group_name = azurerm_resource_group.rg.name
+  location            = azurerm_resource_group.rg.location
+  cluster_name        = azurerm_kusto_cluster.cluster1.name
+}
+
+resource "azurerm_kusto_database" "test" {
+  name                = "acctestkd2-%d"
+  resource_group_name = azurerm_resource_group.rg.name
+  location            = azurerm_resource_group.rg.location
+  cluster_name        = azurerm_kusto_cluster.cluster2.name
+}
+
+resource "azurerm_kusto_attached_database_configuration" "test" {
+  name                = "acctestka-%d"
+  resource_group_name = azurerm_resource_group.rg.name
+  location            = azurerm_resource_group.rg.location
+  cluster_name        = azurerm_kusto_cluster.cluster1.name
+  cluster_resource_id = azurerm_kusto_cluster.cluster2.id
+  database_name       = azurerm_kusto_database.test.name
+
+  sharing {
+    external_tables_to_exclude    = ["ExternalTable2"]
+    external_tables_to_include    = ["ExternalTable1"]
+    materialized_views_to_exclude = ["MaterializedViewTable2"]
+    materialized_views_to_include = ["MaterializedViewTable1"]
+    tables_to_exclude             = ["Table1"]
+    tables_to_include             = ["Table1"]
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, data.RandomString, data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
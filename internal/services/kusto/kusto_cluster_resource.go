@@ -209,6 +209,30 @@ func resourceKustoCluster() *pluginsdk.Resource {
 					Default:  true,
 				},
 
+				"allowed_fqdns": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+
+				"allowed_ip_ranges": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.IsCIDR,
+					},
+				},
+
+				"outbound_network_access_restricted": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
 				"double_encryption_enabled": {
 					Type:     pluginsdk.TypeBool,
 					Optional: true,
@@ -413,6 +437,15 @@ func resourceKustoClusterCreateUpdate(d *pluginsdk.ResourceData, meta interface{
 		}
 	}
 
+	clusterProperties.AllowedFqdnList = utils.ExpandStringSlice(d.Get("allowed_fqdns").([]interface{}))
+	clusterProperties.AllowedIPRangeList = utils.ExpandStringSlice(d.Get("allowed_ip_ranges").([]interface{}))
+
+	restrictOutboundNetworkAccess := kusto.ClusterNetworkAccessFlagDisabled
+	if d.Get("outbound_network_access_restricted").(bool) {
+		restrictOutboundNetworkAccess = kusto.ClusterNetworkAccessFlagEnabled
+	}
+	clusterProperties.RestrictOutboundNetworkAccess = restrictOutboundNetworkAccess
+
 	if v, ok := d.GetOk("virtual_network_configuration"); ok {
 		vnet := expandKustoClusterVNET(v.([]interface{}))
 		clusterProperties.VirtualNetworkConfiguration = vnet
@@ -562,6 +595,9 @@ func resourceKustoClusterRead(d *pluginsdk.ResourceData, meta interface{}) error
 		d.Set("purge_enabled", props.EnablePurge)
 		d.Set("virtual_network_configuration", flattenKustoClusterVNET(props.VirtualNetworkConfiguration))
 		d.Set("language_extensions", flattenKustoClusterLanguageExtensions(props.LanguageExtensions))
+		d.Set("allowed_fqdns", utils.FlattenStringSlice(props.AllowedFqdnList))
+		d.Set("allowed_ip_ranges", utils.FlattenStringSlice(props.AllowedIPRangeList))
+		d.Set("outbound_network_access_restricted", props.RestrictOutboundNetworkAccess == kusto.ClusterNetworkAccessFlagEnabled)
 		d.Set("uri", props.URI)
 		d.Set("data_ingestion_uri", props.DataIngestionURI)
 		d.Set("engine", props.EngineType)
@@ -312,6 +312,33 @@ func TestAccKustoCluster_engineV3(t *testing.T) {
 	})
 }
 
+func TestAccKustoCluster_networkAccessRestrictions(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kusto_cluster", "test")
+	r := KustoClusterResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.networkAccessRestrictions(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("language_extensions.#").HasValue("1"),
+				check.That(data.ResourceName).Key("allowed_fqdns.#").HasValue("1"),
+				check.That(data.ResourceName).Key("allowed_ip_ranges.#").HasValue("2"),
+				check.That(data.ResourceName).Key("outbound_network_access_restricted").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("outbound_network_access_restricted").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccKustoCluster_trustedExternalTenants(t *testing.T) {
 	if features.ThreePointOhBeta() {
 		t.Skip("Skipping since 3.0 mode is enabled")
@@ -980,3 +1007,32 @@ resource "azurerm_kusto_cluster" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomString)
 }
+
+func (KustoClusterResource) networkAccessRestrictions(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_kusto_cluster" "test" {
+  name                = "acctestkc%s"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  sku {
+    name     = "Dev(No SLA)_Standard_D11_v2"
+    capacity = 1
+  }
+
+  language_extensions                = ["PYTHON"]
+  allowed_fqdns                      = ["example.com"]
+  allowed_ip_ranges                  = ["0.0.0.0/0", "10.0.0.0/8"]
+  outbound_network_access_restricted = true
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
@@ -93,10 +93,15 @@ func resourceLighthouseAssignmentCreate(d *pluginsdk.ResourceData, meta interfac
 		},
 	}
 
-	if _, err := client.CreateOrUpdate(ctx, scope, lighthouseAssignmentName, parameters); err != nil {
+	future, err := client.CreateOrUpdate(ctx, scope, lighthouseAssignmentName, parameters)
+	if err != nil {
 		return fmt.Errorf("creating Lighthouse Assignment %q (Scope %q): %+v", lighthouseAssignmentName, scope, err)
 	}
 
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation of Lighthouse Assignment %q (Scope %q): %+v", lighthouseAssignmentName, scope, err)
+	}
+
 	read, err := client.Get(ctx, scope, lighthouseAssignmentName, utils.Bool(false))
 	if err != nil {
 		return fmt.Errorf("retrieving Lighthouse Assessment %q (Scope %q): %+v", lighthouseAssignmentName, scope, err)
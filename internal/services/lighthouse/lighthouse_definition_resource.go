@@ -1,8 +1,10 @@
 package lighthouse
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/managedservices/mgmt/2019-06-01/managedservices"
@@ -12,18 +14,25 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/lighthouse/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/lighthouse/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+// userAccessAdministratorRoleDefinitionId is the ID of the built-in `User Access Administrator` role
+const userAccessAdministratorRoleDefinitionId = "18d7d88d-d35e-4fb5-a5c3-7773c20a72d9"
+
 func resourceLighthouseDefinition() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceLighthouseDefinitionCreateUpdate,
 		Read:   resourceLighthouseDefinitionRead,
 		Update: resourceLighthouseDefinitionCreateUpdate,
 		Delete: resourceLighthouseDefinitionDelete,
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceLighthouseDefinitionCustomizeDiff),
+
 		// TODO: replace this with an importer which validates the ID during import
 		Importer: pluginsdk.DefaultImporter(),
 
@@ -71,7 +80,7 @@ func resourceLighthouseDefinition() *pluginsdk.Resource {
 						"role_definition_id": {
 							Type:         pluginsdk.TypeString,
 							Required:     true,
-							ValidateFunc: validation.IsUUID,
+							ValidateFunc: validate.LighthouseRoleDefinitionID,
 						},
 
 						"principal_display_name": {
@@ -141,6 +150,28 @@ func resourceLighthouseDefinition() *pluginsdk.Resource {
 	}
 }
 
+func resourceLighthouseDefinitionCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, meta interface{}) error {
+	// the authorizations may be interpolated from values which aren't known until apply
+	if !diff.HasChange("authorization") || !diff.NewValueKnown("authorization") {
+		return nil
+	}
+
+	for _, item := range diff.Get("authorization").(*pluginsdk.Set).List() {
+		v := item.(map[string]interface{})
+		roleDefinitionId := v["role_definition_id"].(string)
+		if roleDefinitionId == "" {
+			continue
+		}
+
+		// only the `User Access Administrator` role is allowed to assign roles to managed identities in the customer tenant
+		if v["delegated_role_definition_ids"].(*pluginsdk.Set).Len() > 0 && !strings.EqualFold(roleDefinitionId, userAccessAdministratorRoleDefinitionId) {
+			return fmt.Errorf("`delegated_role_definition_ids` can only be specified when `role_definition_id` is the `User Access Administrator` role (%q) for the principal %q", userAccessAdministratorRoleDefinitionId, v["principal_id"].(string))
+		}
+	}
+
+	return nil
+}
+
 func resourceLighthouseDefinitionCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Lighthouse.DefinitionsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
@@ -189,10 +220,15 @@ func resourceLighthouseDefinitionCreateUpdate(d *pluginsdk.ResourceData, meta in
 		},
 	}
 
-	if _, err := client.CreateOrUpdate(ctx, lighthouseDefinitionID, scope, parameters); err != nil {
+	future, err := client.CreateOrUpdate(ctx, lighthouseDefinitionID, scope, parameters)
+	if err != nil {
 		return fmt.Errorf("Creating/Updating Lighthouse Definition %q (Scope %q): %+v", lighthouseDefinitionID, scope, err)
 	}
 
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation/update of Lighthouse Definition %q (Scope %q): %+v", lighthouseDefinitionID, scope, err)
+	}
+
 	read, err := client.Get(ctx, scope, lighthouseDefinitionID)
 	if err != nil {
 		return err
@@ -0,0 +1,23 @@
+package validate
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// ownerRoleDefinitionId is the ID of the built-in `Owner` role, which can't be delegated using Azure Lighthouse
+const ownerRoleDefinitionId = "8e3af657-a8ff-443c-a75c-2fe8c4bcb635"
+
+func LighthouseRoleDefinitionID(i interface{}, k string) (warnings []string, errors []error) {
+	if warnings, errors = validation.IsUUID(i, k); len(errors) > 0 {
+		return
+	}
+
+	if strings.EqualFold(i.(string), ownerRoleDefinitionId) {
+		errors = append(errors, fmt.Errorf("%q cannot be the built-in `Owner` role, since it isn't supported by Azure Lighthouse", k))
+	}
+
+	return
+}
@@ -0,0 +1,56 @@
+package validate
+
+import "testing"
+
+func TestLighthouseRoleDefinitionID(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			// empty
+			Input: "",
+			Valid: false,
+		},
+		{
+			// not a uuid
+			Input: "Contributor",
+			Valid: false,
+		},
+		{
+			// a full role definition resource id
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/roleDefinitions/b24988ac-6180-42a0-ab88-20f7382dd24c",
+			Valid: false,
+		},
+		{
+			// Owner
+			Input: "8e3af657-a8ff-443c-a75c-2fe8c4bcb635",
+			Valid: false,
+		},
+		{
+			// Owner upper-cased
+			Input: "8E3AF657-A8FF-443C-A75C-2FE8C4BCB635",
+			Valid: false,
+		},
+		{
+			// Contributor
+			Input: "b24988ac-6180-42a0-ab88-20f7382dd24c",
+			Valid: true,
+		},
+		{
+			// User Access Administrator
+			Input: "18d7d88d-d35e-4fb5-a5c3-7773c20a72d9",
+			Valid: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %s", tc.Input)
+		_, errors := LighthouseRoleDefinitionID(tc.Input, "role_definition_id")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t", tc.Valid, valid)
+		}
+	}
+}
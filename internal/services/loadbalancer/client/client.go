@@ -9,6 +9,8 @@ type Client struct {
 	LoadBalancersClient                   *network.LoadBalancersClient
 	LoadBalancerBackendAddressPoolsClient *network.LoadBalancerBackendAddressPoolsClient
 	LoadBalancingRulesClient              *network.LoadBalancerLoadBalancingRulesClient
+
+	options *common.ClientOptions
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -25,5 +27,13 @@ func NewClient(o *common.ClientOptions) *Client {
 		LoadBalancersClient:                   &loadBalancersClient,
 		LoadBalancerBackendAddressPoolsClient: &loadBalancerBackendAddressPoolsClient,
 		LoadBalancingRulesClient:              &loadBalancingRulesClient,
+
+		options: o,
 	}
 }
+
+func (c Client) LoadBalancersClientForSubscription(subscriptionID string) *network.LoadBalancersClient {
+	loadBalancersClient := network.NewLoadBalancersClientWithBaseURI(c.options.ResourceManagerEndpoint, subscriptionID)
+	c.options.ConfigureClient(&loadBalancersClient.Client, c.options.ResourceManagerAuthorizer)
+	return &loadBalancersClient
+}
@@ -25,6 +25,7 @@ func TestLoadTest_basic(t *testing.T) {
 			Config: r.basic(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("dataplane_uri").IsSet(),
 			),
 		},
 		data.ImportStep(),
@@ -1,6 +1,7 @@
 package machinelearning
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -9,9 +10,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/machinelearning/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/machinelearning/validate"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -36,6 +40,8 @@ func resourceComputeCluster() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceComputeClusterCustomizeDiff),
+
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:     pluginsdk.TypeString,
@@ -44,17 +50,19 @@ func resourceComputeCluster() *pluginsdk.Resource {
 			},
 
 			"machine_learning_workspace_id": {
-				Type:     pluginsdk.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.WorkspaceID,
 			},
 
 			"location": azure.SchemaLocation(),
 
 			"vm_size": {
-				Type:     pluginsdk.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
 			},
 
 			"vm_priority": {
@@ -81,19 +89,22 @@ func resourceComputeCluster() *pluginsdk.Resource {
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"max_node_count": {
-							Type:     pluginsdk.TypeInt,
-							Required: true,
-							ForceNew: true,
+							Type:         pluginsdk.TypeInt,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntAtLeast(0),
 						},
 						"min_node_count": {
-							Type:     pluginsdk.TypeInt,
-							Required: true,
-							ForceNew: true,
+							Type:         pluginsdk.TypeInt,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntAtLeast(0),
 						},
 						"scale_down_nodes_after_idle_duration": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-							ForceNew: true,
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: azValidate.ISO8601Duration,
 						},
 					},
 				},
@@ -147,10 +158,18 @@ func resourceComputeCluster() *pluginsdk.Resource {
 				Computed: true, // `ssh_public_access_enabled` sets to `true` by default even if unspecified
 			},
 
-			"subnet_resource_id": {
-				Type:     pluginsdk.TypeString,
+			"node_public_ip_enabled": {
+				Type:     pluginsdk.TypeBool,
 				Optional: true,
 				ForceNew: true,
+				Default:  true,
+			},
+
+			"subnet_resource_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: networkValidate.SubnetID,
 			},
 
 			"tags": tags.ForceNewSchema(),
@@ -158,6 +177,24 @@ func resourceComputeCluster() *pluginsdk.Resource {
 	}
 }
 
+func resourceComputeClusterCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+	if scaleSettings := d.Get("scale_settings").([]interface{}); len(scaleSettings) > 0 && scaleSettings[0] != nil {
+		settings := scaleSettings[0].(map[string]interface{})
+		minNodeCount := settings["min_node_count"].(int)
+		maxNodeCount := settings["max_node_count"].(int)
+		if minNodeCount > maxNodeCount {
+			return fmt.Errorf("`scale_settings.0.min_node_count` (%d) must be less than or equal to `scale_settings.0.max_node_count` (%d)", minNodeCount, maxNodeCount)
+		}
+	}
+
+	// Compute Nodes without a Public IP can only communicate through the Virtual Network they're deployed into
+	if !d.Get("node_public_ip_enabled").(bool) && d.NewValueKnown("subnet_resource_id") && d.Get("subnet_resource_id").(string) == "" {
+		return fmt.Errorf("`subnet_resource_id` must be specified when `node_public_ip_enabled` is set to `false`")
+	}
+
+	return nil
+}
+
 func resourceComputeClusterCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	mlWorkspacesClient := meta.(*clients.Client).MachineLearning.WorkspacesClient
 	mlComputeClient := meta.(*clients.Client).MachineLearning.MachineLearningComputeClient
@@ -188,6 +225,7 @@ func resourceComputeClusterCreate(d *pluginsdk.ResourceData, meta interface{}) e
 		VMPriority:             machinelearningservices.VMPriority(d.Get("vm_priority").(string)),
 		ScaleSettings:          expandScaleSettings(d.Get("scale_settings").([]interface{})),
 		UserAccountCredentials: expandUserAccountCredentials(d.Get("ssh").([]interface{})),
+		EnableNodePublicIP:     utils.Bool(d.Get("node_public_ip_enabled").(bool)),
 	}
 
 	computeClusterAmlComputeProperties.RemoteLoginPortPublicAccess = machinelearningservices.RemoteLoginPortPublicAccessDisabled
@@ -287,6 +325,12 @@ func resourceComputeClusterRead(d *pluginsdk.ResourceData, meta interface{}) err
 			d.Set("subnet_resource_id", props.Subnet.ID)
 		}
 
+		nodePublicIpEnabled := true
+		if props.EnableNodePublicIP != nil {
+			nodePublicIpEnabled = *props.EnableNodePublicIP
+		}
+		d.Set("node_public_ip_enabled", nodePublicIpEnabled)
+
 		switch props.RemoteLoginPortPublicAccess {
 		case machinelearningservices.RemoteLoginPortPublicAccessNotSpecified:
 			d.Set("ssh_public_access_enabled", nil)
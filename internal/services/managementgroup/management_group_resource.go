@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/managementgroup/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/managementgroup/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/set"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
@@ -82,7 +83,8 @@ func resourceManagementGroup() *pluginsdk.Resource {
 					Type:         pluginsdk.TypeString,
 					ValidateFunc: validation.IsUUID,
 				},
-				Set: pluginsdk.HashString,
+				// the API can return Subscription IDs in a different casing to the one specified
+				Set: set.HashStringIgnoreCase,
 			},
 		},
 	}
@@ -338,7 +340,7 @@ func expandManagementGroupSubscriptionIds(input *pluginsdk.Set) []string {
 }
 
 func flattenManagementGroupSubscriptionIds(input *[]managementgroups.ChildInfo) (*pluginsdk.Set, error) {
-	subscriptionIds := &pluginsdk.Set{F: pluginsdk.HashString}
+	subscriptionIds := &pluginsdk.Set{F: set.HashStringIgnoreCase}
 	if input == nil {
 		return subscriptionIds, nil
 	}
@@ -413,7 +415,7 @@ func determineManagementGroupSubscriptionsIdsToRemove(existing *[]managementgrou
 
 		found := false
 		for _, subId := range updated {
-			if id.subscriptionId == subId {
+			if strings.EqualFold(id.subscriptionId, subId) {
 				found = true
 				break
 			}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
@@ -180,6 +181,40 @@ func TestAccManagementGroup_withSubscriptions(t *testing.T) {
 	})
 }
 
+func TestAccManagementGroup_withSubscriptionsReordered(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_management_group", "test")
+	r := ManagementGroupResource{}
+	subscriptionID := os.Getenv("ARM_SUBSCRIPTION_ID")
+	altSubscriptionID := os.Getenv("ARM_SUBSCRIPTION_ID_ALT")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.withMultipleSubscriptions(subscriptionID, altSubscriptionID),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("subscription_ids.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config:   r.withMultipleSubscriptions(altSubscriptionID, subscriptionID),
+			PlanOnly: true,
+		},
+		{
+			Config:   r.withMultipleSubscriptions(strings.ToUpper(subscriptionID), altSubscriptionID),
+			PlanOnly: true,
+		},
+		{
+			Config: r.withSubscriptions(subscriptionID),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("subscription_ids.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (ManagementGroupResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.ManagementGroupID(state.ID)
 	if err != nil {
@@ -282,6 +317,21 @@ resource "azurerm_management_group" "test" {
 `, subscriptionID)
 }
 
+func (r ManagementGroupResource) withMultipleSubscriptions(subscriptionID, altSubscriptionID string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_management_group" "test" {
+  subscription_ids = [
+    "%s",
+    "%s",
+  ]
+}
+`, subscriptionID, altSubscriptionID)
+}
+
 func (r ManagementGroupResource) removeSubscriptions() string {
 	return `
 provider "azurerm" {
@@ -1,6 +1,7 @@
 package monitor
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -26,6 +27,8 @@ func resourceMonitorActionRuleSuppression() *pluginsdk.Resource {
 		Update: resourceMonitorActionRuleSuppressionCreateUpdate,
 		Delete: resourceMonitorActionRuleSuppressionDelete,
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceMonitorActionRuleSuppressionCustomizeDiff),
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
@@ -157,6 +160,38 @@ func resourceMonitorActionRuleSuppression() *pluginsdk.Resource {
 	}
 }
 
+func resourceMonitorActionRuleSuppressionCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, meta interface{}) error {
+	startDateKey := "suppression.0.schedule.0.start_date_utc"
+	endDateKey := "suppression.0.schedule.0.end_date_utc"
+
+	// either date may be interpolated from values which aren't known until apply
+	if !diff.NewValueKnown(startDateKey) || !diff.NewValueKnown(endDateKey) {
+		return nil
+	}
+
+	rawStartDate := diff.Get(startDateKey).(string)
+	rawEndDate := diff.Get(endDateKey).(string)
+	if rawStartDate == "" || rawEndDate == "" {
+		return nil
+	}
+
+	startDateUTC, err := time.Parse(time.RFC3339, rawStartDate)
+	if err != nil {
+		return fmt.Errorf("parsing `start_date_utc` %q: %+v", rawStartDate, err)
+	}
+
+	endDateUTC, err := time.Parse(time.RFC3339, rawEndDate)
+	if err != nil {
+		return fmt.Errorf("parsing `end_date_utc` %q: %+v", rawEndDate, err)
+	}
+
+	if !endDateUTC.After(startDateUTC) {
+		return fmt.Errorf("`end_date_utc` must be after `start_date_utc`")
+	}
+
+	return nil
+}
+
 func resourceMonitorActionRuleSuppressionCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Monitor.ActionRulesClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
@@ -3,6 +3,7 @@ package monitor_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
@@ -102,6 +103,18 @@ func TestAccMonitorActionRuleSuppression_updateSuppressionConfig(t *testing.T) {
 	})
 }
 
+func TestAccMonitorActionRuleSuppression_scheduleEndBeforeStart(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_action_rule_suppression", "test")
+	r := MonitorActionRuleSuppressionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.scheduleEndBeforeStart(data),
+			ExpectError: regexp.MustCompile("`end_date_utc` must be after `start_date_utc`"),
+		},
+	})
+}
+
 func (t MonitorActionRuleSuppressionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.ActionRuleID(state.ID)
 	if err != nil {
@@ -267,6 +280,31 @@ resource "azurerm_monitor_action_rule_suppression" "test" {
 `, r.template(data), data.RandomInteger)
 }
 
+func (r MonitorActionRuleSuppressionResource) scheduleEndBeforeStart(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_action_rule_suppression" "test" {
+  name                = "acctest-moniter-%d"
+  resource_group_name = azurerm_resource_group.test.name
+
+  scope {
+    type         = "ResourceGroup"
+    resource_ids = [azurerm_resource_group.test.id]
+  }
+
+  suppression {
+    recurrence_type = "Once"
+
+    schedule {
+      start_date_utc = "2019-01-03T15:02:07Z"
+      end_date_utc   = "2019-01-01T01:02:03Z"
+    }
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
 func (MonitorActionRuleSuppressionResource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -2,6 +2,7 @@ package monitor
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -38,6 +39,8 @@ func resourceMonitorActivityLogAlert() *pluginsdk.Resource {
 			0: migration.ActivityLogAlertUpgradeV0ToV1{},
 		}),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceMonitorActivityLogAlertCustomizeDiff),
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
@@ -566,6 +569,32 @@ func expandResourceHealth(resourceHealth []interface{}, conditions []insights.Al
 	return conditions
 }
 
+func resourceMonitorActivityLogAlertCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+	if !d.NewValueKnown("criteria.0.category") {
+		return nil
+	}
+	category := d.Get("criteria.0.category").(string)
+
+	// `service_health` and `resource_health` are Optional & Computed, so they're only checked when they've been changed
+	// in the config rather than when a previous value has been retained after the category was changed
+	healthCriteria := map[string]string{
+		"service_health":  "ServiceHealth",
+		"resource_health": "ResourceHealth",
+	}
+	for field, expectedCategory := range healthCriteria {
+		key := fmt.Sprintf("criteria.0.%s", field)
+		if !d.HasChange(key) || category == expectedCategory {
+			continue
+		}
+
+		if criteria := d.Get(key).([]interface{}); len(criteria) > 0 && criteria[0] != nil {
+			return fmt.Errorf("`%s` can only be specified when `criteria.0.category` is `%s`", key, expectedCategory)
+		}
+	}
+
+	return nil
+}
+
 func expandServiceHealth(serviceHealth []interface{}, conditions []insights.AlertRuleAnyOfOrLeafCondition) []insights.AlertRuleAnyOfOrLeafCondition {
 	for _, serviceItem := range serviceHealth {
 		if serviceItem == nil {
@@ -3,6 +3,7 @@ package monitor_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
@@ -233,6 +234,9 @@ func TestAccMonitorActivityLogAlert_ServiceHealth_basicAndUpdate(t *testing.T) {
 			Config: r.serviceHealth_basic(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("criteria.0.service_health.0.events.#").HasValue("2"),
+				check.That(data.ResourceName).Key("criteria.0.service_health.0.services.#").HasValue("2"),
+				check.That(data.ResourceName).Key("criteria.0.service_health.0.locations.#").HasValue("2"),
 			),
 		},
 		data.ImportStep(),
@@ -253,6 +257,18 @@ func TestAccMonitorActivityLogAlert_ServiceHealth_basicAndUpdate(t *testing.T) {
 	})
 }
 
+func TestAccMonitorActivityLogAlert_ServiceHealth_wrongCategory(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_activity_log_alert", "test")
+	r := MonitorActivityLogAlertResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.serviceHealth_wrongCategory(data),
+			ExpectError: regexp.MustCompile("`criteria.0.service_health` can only be specified when `criteria.0.category` is `ServiceHealth`"),
+		},
+	})
+}
+
 func TestAccMonitorActivityLogAlert_ServiceHealth_basicAndDelete(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_monitor_activity_log_alert", "test")
 	r := MonitorActivityLogAlertResource{}
@@ -568,6 +584,32 @@ resource "azurerm_monitor_activity_log_alert" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomString, data.RandomInteger)
 }
 
+func (MonitorActivityLogAlertResource) serviceHealth_wrongCategory(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_monitor_activity_log_alert" "test" {
+  name                = "acctestActivityLogAlert-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  scopes              = [azurerm_resource_group.test.id]
+
+  criteria {
+    category = "Administrative"
+    service_health {
+      events = ["Incident"]
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
 func (MonitorActivityLogAlertResource) serviceHealth_update(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -3,6 +3,7 @@ package network_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
@@ -98,6 +99,30 @@ func TestAccBastionHost_scaleUnits(t *testing.T) {
 	})
 }
 
+func TestAccBastionHost_basicSkuFeatureGating(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_bastion_host", "test")
+	r := BastionHostResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.basicSkuWithFeature(data, "file_copy_enabled"),
+			ExpectError: regexp.MustCompile("`file_copy_enabled` is only supported when `sku` is `Standard`"),
+		},
+		{
+			Config:      r.basicSkuWithFeature(data, "ip_connect_enabled"),
+			ExpectError: regexp.MustCompile("`ip_connect_enabled` is only supported when `sku` is `Standard`"),
+		},
+		{
+			Config:      r.basicSkuWithFeature(data, "tunneling_enabled"),
+			ExpectError: regexp.MustCompile("`tunneling_enabled` is only supported when `sku` is `Standard`"),
+		},
+		{
+			Config:      r.basicSkuWithFeature(data, "shareable_link_enabled"),
+			ExpectError: regexp.MustCompile("`shareable_link_enabled` is only supported when `sku` is `Standard`"),
+		},
+	})
+}
+
 func (BastionHostResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.BastionHostID(state.ID)
 	if err != nil {
@@ -159,6 +184,56 @@ resource "azurerm_bastion_host" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomString, data.RandomInteger, data.RandomString)
 }
 
+func (BastionHostResource) basicSkuWithFeature(data acceptance.TestData, feature string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-bastion-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestVNet%s"
+  address_space       = ["192.168.1.0/24"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "AzureBastionSubnet"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefix       = "192.168.1.224/27"
+}
+
+resource "azurerm_public_ip" "test" {
+  name                = "acctestBastionPIP%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  allocation_method   = "Static"
+  sku                 = "Standard"
+}
+
+resource "azurerm_bastion_host" "test" {
+  name                = "acctestBastion%s"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Basic"
+
+  %s = true
+
+  ip_configuration {
+    name                 = "ip-configuration"
+    subnet_id            = azurerm_subnet.test.id
+    public_ip_address_id = azurerm_public_ip.test.id
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, data.RandomInteger, data.RandomString, feature)
+}
+
 func (BastionHostResource) standardSku(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
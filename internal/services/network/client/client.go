@@ -10,6 +10,7 @@ type Client struct {
 	ApplicationSecurityGroupsClient        *network.ApplicationSecurityGroupsClient
 	BastionHostsClient                     *network.BastionHostsClient
 	ConnectionMonitorsClient               *network.ConnectionMonitorsClient
+	CustomIPPrefixesClient                 *network.CustomIPPrefixesClient
 	DDOSProtectionPlansClient              *network.DdosProtectionPlansClient
 	ExpressRouteAuthsClient                *network.ExpressRouteCircuitAuthorizationsClient
 	ExpressRouteCircuitsClient             *network.ExpressRouteCircuitsClient
@@ -34,6 +35,9 @@ type Client struct {
 	RoutesClient                           *network.RoutesClient
 	RouteFiltersClient                     *network.RouteFiltersClient
 	RouteTablesClient                      *network.RouteTablesClient
+	RoutingIntentClient                    *network.RoutingIntentClient
+	VirtualAppliancesClient                *network.VirtualAppliancesClient
+	VirtualApplianceSkusClient             *network.VirtualApplianceSkusClient
 	SecurityGroupClient                    *network.SecurityGroupsClient
 	SecurityPartnerProviderClient          *network.SecurityPartnerProvidersClient
 	SecurityRuleClient                     *network.SecurityRulesClient
@@ -75,6 +79,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	ConnectionMonitorsClient := network.NewConnectionMonitorsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&ConnectionMonitorsClient.Client, o.ResourceManagerAuthorizer)
 
+	CustomIPPrefixesClient := network.NewCustomIPPrefixesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&CustomIPPrefixesClient.Client, o.ResourceManagerAuthorizer)
+
 	DDOSProtectionPlansClient := network.NewDdosProtectionPlansClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&DDOSProtectionPlansClient.Client, o.ResourceManagerAuthorizer)
 
@@ -162,6 +169,15 @@ func NewClient(o *common.ClientOptions) *Client {
 	RouteTablesClient := network.NewRouteTablesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&RouteTablesClient.Client, o.ResourceManagerAuthorizer)
 
+	RoutingIntentClient := network.NewRoutingIntentClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&RoutingIntentClient.Client, o.ResourceManagerAuthorizer)
+
+	VirtualAppliancesClient := network.NewVirtualAppliancesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&VirtualAppliancesClient.Client, o.ResourceManagerAuthorizer)
+
+	VirtualApplianceSkusClient := network.NewVirtualApplianceSkusClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&VirtualApplianceSkusClient.Client, o.ResourceManagerAuthorizer)
+
 	SecurityGroupClient := network.NewSecurityGroupsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&SecurityGroupClient.Client, o.ResourceManagerAuthorizer)
 
@@ -230,6 +246,7 @@ func NewClient(o *common.ClientOptions) *Client {
 		ApplicationSecurityGroupsClient:        &ApplicationSecurityGroupsClient,
 		BastionHostsClient:                     &BastionHostsClient,
 		ConnectionMonitorsClient:               &ConnectionMonitorsClient,
+		CustomIPPrefixesClient:                 &CustomIPPrefixesClient,
 		DDOSProtectionPlansClient:              &DDOSProtectionPlansClient,
 		ExpressRouteAuthsClient:                &ExpressRouteAuthsClient,
 		ExpressRouteCircuitsClient:             &ExpressRouteCircuitsClient,
@@ -254,6 +271,9 @@ func NewClient(o *common.ClientOptions) *Client {
 		RoutesClient:                           &RoutesClient,
 		RouteFiltersClient:                     &RouteFiltersClient,
 		RouteTablesClient:                      &RouteTablesClient,
+		RoutingIntentClient:                    &RoutingIntentClient,
+		VirtualAppliancesClient:                &VirtualAppliancesClient,
+		VirtualApplianceSkusClient:             &VirtualApplianceSkusClient,
 		SecurityGroupClient:                    &SecurityGroupClient,
 		SecurityPartnerProviderClient:          &SecurityPartnerProviderClient,
 		SecurityRuleClient:                     &SecurityRuleClient,
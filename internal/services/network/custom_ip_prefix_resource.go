@@ -0,0 +1,262 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceCustomIpPrefix() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceCustomIpPrefixCreate,
+		Read:   resourceCustomIpPrefixRead,
+		Update: resourceCustomIpPrefixUpdate,
+		Delete: resourceCustomIpPrefixDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.CustomIpPrefixID(id)
+			return err
+		}),
+
+		// provisioning (and deprovisioning) a Custom IP Prefix involves validating the ROA with the
+		// Regional Internet Registry, which can take several hours
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(9 * time.Hour),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(9 * time.Hour),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": azure.SchemaLocation(),
+
+			"cidr": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsCIDR,
+			},
+
+			"roa_validity_end_date": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.CustomIpPrefixRoaValidityEndDate,
+			},
+
+			"wan_validation_signed_message": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"commissioned_state": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceCustomIpPrefixCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.CustomIPPrefixesClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewCustomIpPrefixID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_custom_ip_prefix", id.ID())
+	}
+
+	cidr := d.Get("cidr").(string)
+
+	// the authorization message is made up of the Subscription ID, the CIDR and the ROA expiry date (as `yyyymmdd`)
+	roaValidityEndDate, err := time.Parse("2006-01-02", d.Get("roa_validity_end_date").(string))
+	if err != nil {
+		return fmt.Errorf("parsing `roa_validity_end_date`: %+v", err)
+	}
+	authorizationMessage := fmt.Sprintf("%s|%s|%s", subscriptionId, cidr, roaValidityEndDate.Format("20060102"))
+
+	parameters := network.CustomIPPrefix{
+		Location: utils.String(location.Normalize(d.Get("location").(string))),
+		CustomIPPrefixPropertiesFormat: &network.CustomIPPrefixPropertiesFormat{
+			Cidr:                 utils.String(cidr),
+			AuthorizationMessage: utils.String(authorizationMessage),
+			SignedMessage:        utils.String(d.Get("wan_validation_signed_message").(string)),
+		},
+		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, parameters)
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+	}
+
+	// the ID is set before waiting for provisioning (which can take hours) so that the resource is tracked if this fails
+	d.SetId(id.ID())
+
+	// the resource is created straight away but the validation of the prefix continues in the background
+	log.Printf("[DEBUG] Waiting for %s to finish provisioning", id)
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending:    []string{string(network.CommissionedStateProvisioning)},
+		Target:     []string{string(network.CommissionedStateProvisioned)},
+		Refresh:    customIpPrefixCommissionedStateRefreshFunc(ctx, client, id),
+		MinTimeout: 1 * time.Minute,
+		Timeout:    d.Timeout(pluginsdk.TimeoutCreate),
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for %s to finish provisioning: %+v", id, err)
+	}
+
+	return resourceCustomIpPrefixRead(d, meta)
+}
+
+func resourceCustomIpPrefixRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.CustomIPPrefixesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.CustomIpPrefixID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("location", location.NormalizeNilable(resp.Location))
+
+	if props := resp.CustomIPPrefixPropertiesFormat; props != nil {
+		d.Set("cidr", props.Cidr)
+		d.Set("commissioned_state", string(props.CommissionedState))
+
+		if props.SignedMessage != nil {
+			d.Set("wan_validation_signed_message", props.SignedMessage)
+		}
+
+		if props.AuthorizationMessage != nil {
+			segments := strings.Split(*props.AuthorizationMessage, "|")
+			if len(segments) == 3 {
+				roaValidityEndDate, err := time.Parse("20060102", segments[2])
+				if err != nil {
+					return fmt.Errorf("parsing the ROA expiry date from the authorization message %q: %+v", *props.AuthorizationMessage, err)
+				}
+				d.Set("roa_validity_end_date", roaValidityEndDate.Format("2006-01-02"))
+			}
+		}
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceCustomIpPrefixUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.CustomIPPrefixesClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.CustomIpPrefixID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("tags") {
+		parameters := network.TagsObject{
+			Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		}
+		if _, err := client.UpdateTags(ctx, id.ResourceGroup, id.Name, parameters); err != nil {
+			return fmt.Errorf("updating tags for %s: %+v", *id, err)
+		}
+	}
+
+	return resourceCustomIpPrefixRead(d, meta)
+}
+
+func resourceCustomIpPrefixDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.CustomIPPrefixesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.CustomIpPrefixID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func customIpPrefixCommissionedStateRefreshFunc(ctx context.Context, client *network.CustomIPPrefixesClient, id parse.CustomIpPrefixId) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+		if err != nil {
+			return nil, "", fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+
+		if props := resp.CustomIPPrefixPropertiesFormat; props != nil {
+			if props.FailedReason != nil && *props.FailedReason != "" {
+				return resp, "", fmt.Errorf("provisioning failed: %s", *props.FailedReason)
+			}
+
+			return resp, string(props.CommissionedState), nil
+		}
+
+		return resp, string(network.CommissionedStateProvisioning), nil
+	}
+}
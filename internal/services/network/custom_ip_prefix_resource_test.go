@@ -0,0 +1,187 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type CustomIpPrefixResource struct {
+	cidr               string
+	roaValidityEndDate string
+	signedMessage      string
+}
+
+func TestAccCustomIpPrefix_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_custom_ip_prefix", "test")
+	r := newCustomIpPrefixResource(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("commissioned_state").HasValue("Provisioned"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccCustomIpPrefix_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_custom_ip_prefix", "test")
+	r := newCustomIpPrefixResource(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccCustomIpPrefix_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_custom_ip_prefix", "test")
+	r := newCustomIpPrefixResource(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.withPublicIpPrefix(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("tags.%").HasValue("1"),
+				check.That("azurerm_public_ip_prefix.test").Key("custom_ip_prefix_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+// newCustomIpPrefixResource returns the details of an IP range which has been registered with a Regional
+// Internet Registry (and so can be brought to Azure), since these can't be provisioned as a part of the test
+func newCustomIpPrefixResource(t *testing.T) CustomIpPrefixResource {
+	variables := []string{
+		"ARM_TEST_CUSTOM_IP_PREFIX_CIDR",
+		"ARM_TEST_CUSTOM_IP_PREFIX_ROA_VALIDITY_END_DATE",
+		"ARM_TEST_CUSTOM_IP_PREFIX_WAN_VALIDATION_SIGNED_MESSAGE",
+	}
+
+	for _, variable := range variables {
+		if os.Getenv(variable) == "" {
+			t.Skipf("`%s` must be set for acceptance tests!", variable)
+		}
+	}
+
+	return CustomIpPrefixResource{
+		cidr:               os.Getenv("ARM_TEST_CUSTOM_IP_PREFIX_CIDR"),
+		roaValidityEndDate: os.Getenv("ARM_TEST_CUSTOM_IP_PREFIX_ROA_VALIDITY_END_DATE"),
+		signedMessage:      os.Getenv("ARM_TEST_CUSTOM_IP_PREFIX_WAN_VALIDATION_SIGNED_MESSAGE"),
+	}
+}
+
+func (r CustomIpPrefixResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.CustomIpPrefixID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.CustomIPPrefixesClient.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.CustomIPPrefixPropertiesFormat != nil), nil
+}
+
+func (r CustomIpPrefixResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-cip-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_custom_ip_prefix" "test" {
+  name                          = "acctest-cip-%[1]d"
+  resource_group_name           = azurerm_resource_group.test.name
+  location                      = azurerm_resource_group.test.location
+  cidr                          = "%[3]s"
+  roa_validity_end_date         = "%[4]s"
+  wan_validation_signed_message = "%[5]s"
+}
+`, data.RandomInteger, data.Locations.Primary, r.cidr, r.roaValidityEndDate, r.signedMessage)
+}
+
+func (r CustomIpPrefixResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_custom_ip_prefix" "import" {
+  name                          = azurerm_custom_ip_prefix.test.name
+  resource_group_name           = azurerm_custom_ip_prefix.test.resource_group_name
+  location                      = azurerm_custom_ip_prefix.test.location
+  cidr                          = azurerm_custom_ip_prefix.test.cidr
+  roa_validity_end_date         = azurerm_custom_ip_prefix.test.roa_validity_end_date
+  wan_validation_signed_message = azurerm_custom_ip_prefix.test.wan_validation_signed_message
+}
+`, r.basic(data))
+}
+
+func (r CustomIpPrefixResource) withPublicIpPrefix(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-cip-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_custom_ip_prefix" "test" {
+  name                          = "acctest-cip-%[1]d"
+  resource_group_name           = azurerm_resource_group.test.name
+  location                      = azurerm_resource_group.test.location
+  cidr                          = "%[3]s"
+  roa_validity_end_date         = "%[4]s"
+  wan_validation_signed_message = "%[5]s"
+
+  tags = {
+    environment = "Test"
+  }
+}
+
+resource "azurerm_public_ip_prefix" "test" {
+  name                = "acctest-pipp-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  prefix_length       = 30
+  custom_ip_prefix_id = azurerm_custom_ip_prefix.test.id
+}
+`, data.RandomInteger, data.Locations.Primary, r.cidr, r.roaValidityEndDate, r.signedMessage)
+}
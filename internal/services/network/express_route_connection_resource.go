@@ -1,7 +1,9 @@
 package network
 
 import (
+	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
@@ -22,6 +24,8 @@ func resourceExpressRouteConnection() *pluginsdk.Resource {
 		Update: resourceExpressRouteConnectionUpdate,
 		Delete: resourceExpressRouteConnectionDelete,
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceExpressRouteConnectionCustomizeDiff),
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
@@ -291,6 +295,61 @@ func resourceExpressRouteConnectionDelete(d *pluginsdk.ResourceData, meta interf
 	return nil
 }
 
+func resourceExpressRouteConnectionCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, meta interface{}) error {
+	if !diff.HasChange("routing") {
+		return nil
+	}
+
+	// either of these may be interpolated from resources which don't exist yet
+	if !diff.NewValueKnown("express_route_gateway_id") || !diff.NewValueKnown("routing.0.associated_route_table_id") {
+		return nil
+	}
+
+	associatedRouteTableId := diff.Get("routing.0.associated_route_table_id").(string)
+	if associatedRouteTableId == "" {
+		return nil
+	}
+
+	gatewayId, err := parse.ExpressRouteGatewayID(diff.Get("express_route_gateway_id").(string))
+	if err != nil {
+		return err
+	}
+
+	return validateExpressRouteConnectionAssociatedRouteTable(ctx, meta.(*clients.Client).Network.ExpressRouteGatewaysClient, *gatewayId, associatedRouteTableId)
+}
+
+// validateExpressRouteConnectionAssociatedRouteTable ensures the associated Route Table lives in the same Virtual Hub
+// as the ExpressRoute Gateway, since the API otherwise fails with an unclear error once the Connection is provisioning
+func validateExpressRouteConnectionAssociatedRouteTable(ctx context.Context, client *network.ExpressRouteGatewaysClient, gatewayId parse.ExpressRouteGatewayId, associatedRouteTableId string) error {
+	routeTableId, err := parse.HubRouteTableID(associatedRouteTableId)
+	if err != nil {
+		return err
+	}
+
+	gateway, err := client.Get(ctx, gatewayId.ResourceGroup, gatewayId.Name)
+	if err != nil {
+		// the ExpressRoute Gateway may not exist yet, in which case this is left to the API
+		if utils.ResponseWasNotFound(gateway.Response) {
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", gatewayId, err)
+	}
+
+	if props := gateway.ExpressRouteGatewayProperties; props != nil && props.VirtualHub != nil && props.VirtualHub.ID != nil {
+		virtualHubId, err := parse.VirtualHubID(*props.VirtualHub.ID)
+		if err != nil {
+			return err
+		}
+
+		routeTableVirtualHubId := parse.NewVirtualHubID(routeTableId.SubscriptionId, routeTableId.ResourceGroup, routeTableId.VirtualHubName)
+		if !strings.EqualFold(virtualHubId.ID(), routeTableVirtualHubId.ID()) {
+			return fmt.Errorf("`associated_route_table_id` must reference a Route Table within the Virtual Hub %q used by the ExpressRoute Gateway, got a Route Table within the Virtual Hub %q", virtualHubId.ID(), routeTableVirtualHubId.ID())
+		}
+	}
+
+	return nil
+}
+
 func expandExpressRouteConnectionRouting(input []interface{}) *network.RoutingConfiguration {
 	if len(input) == 0 || input[0] == nil {
 		return &network.RoutingConfiguration{}
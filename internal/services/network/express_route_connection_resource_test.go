@@ -3,6 +3,7 @@ package network_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
@@ -18,10 +19,12 @@ type ExpressRouteConnectionResource struct{}
 func TestAccExpressRouteConnection(t *testing.T) {
 	acceptance.RunTestsInSequence(t, map[string]map[string]func(t *testing.T){
 		"Resource": {
-			"basic":          testAccExpressRouteConnection_basic,
-			"requiresImport": testAccExpressRouteConnection_requiresImport,
-			"complete":       testAccExpressRouteConnection_complete,
-			"update":         testAccExpressRouteConnection_update,
+			"basic":                       testAccExpressRouteConnection_basic,
+			"requiresImport":              testAccExpressRouteConnection_requiresImport,
+			"complete":                    testAccExpressRouteConnection_complete,
+			"update":                      testAccExpressRouteConnection_update,
+			"customRouting":               testAccExpressRouteConnection_customRouting,
+			"routeTableInOtherVirtualHub": testAccExpressRouteConnection_routeTableInOtherVirtualHub,
 		},
 	})
 }
@@ -102,6 +105,35 @@ func testAccExpressRouteConnection_update(t *testing.T) {
 	})
 }
 
+func testAccExpressRouteConnection_customRouting(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_express_route_connection", "test")
+	r := ExpressRouteConnectionResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.customRouting(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("routing.0.propagated_route_table.0.labels.#").HasValue("2"),
+				check.That(data.ResourceName).Key("routing.0.propagated_route_table.0.route_table_ids.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func testAccExpressRouteConnection_routeTableInOtherVirtualHub(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_express_route_connection", "test")
+	r := ExpressRouteConnectionResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.routeTableInOtherVirtualHub(data),
+			ExpectError: regexp.MustCompile("`associated_route_table_id` must reference a Route Table within the Virtual Hub"),
+		},
+	})
+}
+
 func (r ExpressRouteConnectionResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	expressRouteConnectionClient := client.Network.ExpressRouteConnectionsClient
 	id, err := parse.ExpressRouteConnectionID(state.ID)
@@ -172,6 +204,65 @@ resource "azurerm_express_route_connection" "test" {
 `, r.template(data), data.RandomInteger)
 }
 
+func (r ExpressRouteConnectionResource) customRouting(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_hub_route_table" "second" {
+  name           = "acctest-vhubrt2-%[2]d"
+  virtual_hub_id = azurerm_virtual_hub.test.id
+  labels         = ["label2"]
+}
+
+resource "azurerm_express_route_connection" "test" {
+  name                             = "acctest-ExpressRouteConnection-%[2]d"
+  express_route_gateway_id         = azurerm_express_route_gateway.test.id
+  express_route_circuit_peering_id = azurerm_express_route_circuit_peering.test.id
+
+  routing {
+    associated_route_table_id = azurerm_virtual_hub_route_table.second.id
+
+    propagated_route_table {
+      labels = ["label1", "label2"]
+      route_table_ids = [
+        azurerm_virtual_hub_route_table.test.id,
+        azurerm_virtual_hub_route_table.second.id,
+      ]
+    }
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ExpressRouteConnectionResource) routeTableInOtherVirtualHub(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_hub" "other" {
+  name                = "acctest-vhub2-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  virtual_wan_id      = azurerm_virtual_wan.test.id
+  address_prefix      = "10.0.2.0/24"
+}
+
+resource "azurerm_virtual_hub_route_table" "other" {
+  name           = "acctest-vhubrt2-%[2]d"
+  virtual_hub_id = azurerm_virtual_hub.other.id
+}
+
+resource "azurerm_express_route_connection" "test" {
+  name                             = "acctest-ExpressRouteConnection-%[2]d"
+  express_route_gateway_id         = azurerm_express_route_gateway.test.id
+  express_route_circuit_peering_id = azurerm_express_route_circuit_peering.test.id
+
+  routing {
+    associated_route_table_id = azurerm_virtual_hub_route_table.other.id
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
 func (r ExpressRouteConnectionResource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -1,6 +1,7 @@
 package network
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -11,6 +12,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	lbclient "github.com/hashicorp/terraform-provider-azurerm/internal/services/loadbalancer/client"
+	lbparse "github.com/hashicorp/terraform-provider-azurerm/internal/services/loadbalancer/parse"
 	lbvalidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/loadbalancer/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
@@ -35,6 +38,8 @@ func resourceNetworkInterface() *pluginsdk.Resource {
 			return err
 		}),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceNetworkInterfaceCustomizeDiff),
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
@@ -524,6 +529,53 @@ func resourceNetworkInterfaceDelete(d *pluginsdk.ResourceData, meta interface{})
 	return nil
 }
 
+func resourceNetworkInterfaceCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, meta interface{}) error {
+	if !diff.HasChange("ip_configuration") {
+		return nil
+	}
+
+	return validateNetworkInterfaceGatewayLoadBalancers(ctx, diff, meta.(*clients.Client).LoadBalancers)
+}
+
+// validateNetworkInterfaceGatewayLoadBalancers ensures that any Frontend IP Configurations chained to an IP Configuration
+// belong to a Load Balancer using the `Gateway` SKU, since the API otherwise returns an unclear error
+func validateNetworkInterfaceGatewayLoadBalancers(ctx context.Context, diff *pluginsdk.ResourceDiff, client *lbclient.Client) error {
+	for i, configRaw := range diff.Get("ip_configuration").([]interface{}) {
+		data := configRaw.(map[string]interface{})
+
+		key := fmt.Sprintf("ip_configuration.%d.gateway_load_balancer_frontend_ip_configuration_id", i)
+		if !diff.NewValueKnown(key) {
+			continue
+		}
+
+		v := data["gateway_load_balancer_frontend_ip_configuration_id"].(string)
+		if v == "" {
+			continue
+		}
+
+		frontendId, err := lbparse.LoadBalancerFrontendIpConfigurationID(v)
+		if err != nil {
+			return err
+		}
+
+		// Gateway Load Balancers are commonly chained from (and so are defined in) a different Subscription
+		loadBalancer, err := client.LoadBalancersClientForSubscription(frontendId.SubscriptionId).Get(ctx, frontendId.ResourceGroup, frontendId.LoadBalancerName, "")
+		if err != nil {
+			// the Load Balancer may not exist yet, in which case this is left to the API
+			if utils.ResponseWasNotFound(loadBalancer.Response) {
+				continue
+			}
+			return fmt.Errorf("retrieving Load Balancer %q (Resource Group %q / Subscription %q): %+v", frontendId.LoadBalancerName, frontendId.ResourceGroup, frontendId.SubscriptionId, err)
+		}
+
+		if loadBalancer.Sku == nil || loadBalancer.Sku.Name != network.LoadBalancerSkuNameGateway {
+			return fmt.Errorf("`gateway_load_balancer_frontend_ip_configuration_id` for the `ip_configuration` %q must reference a Frontend IP Configuration of a Load Balancer with the `Gateway` SKU, but Load Balancer %q (Resource Group %q) does not use the `Gateway` SKU", data["name"].(string), frontendId.LoadBalancerName, frontendId.ResourceGroup)
+		}
+	}
+
+	return nil
+}
+
 func expandNetworkInterfaceIPConfigurations(input []interface{}) (*[]network.InterfaceIPConfiguration, error) {
 	ipConfigs := make([]network.InterfaceIPConfiguration, 0)
 
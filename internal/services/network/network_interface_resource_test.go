@@ -3,6 +3,7 @@ package network_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
@@ -318,12 +319,24 @@ func TestAccNetworkInterface_pointToGatewayLB(t *testing.T) {
 			Config: r.pointToGatewayLB(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("ip_configuration.0.gateway_load_balancer_frontend_ip_configuration_id").Exists(),
 			),
 		},
 		data.ImportStep(),
 	})
 }
 
+func TestAccNetworkInterface_pointToNonGatewayLB(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_interface", "test")
+	r := NetworkInterfaceResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.pointToNonGatewayLB(data),
+			ExpectError: regexp.MustCompile("must reference a Frontend IP Configuration of a Load Balancer with the `Gateway` SKU"),
+		},
+	})
+}
+
 func (t NetworkInterfaceResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.NetworkInterfaceID(state.ID)
 	if err != nil {
@@ -807,6 +820,37 @@ resource "azurerm_network_interface" "test" {
 `, r.template(data), data.RandomInteger)
 }
 
+func (r NetworkInterfaceResource) pointToNonGatewayLB(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_lb" "standard" {
+  name                = "acctestlb-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Standard"
+
+  frontend_ip_configuration {
+    name      = "feip"
+    subnet_id = azurerm_subnet.test.id
+  }
+}
+
+resource "azurerm_network_interface" "test" {
+  name                = "acctestnic-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  ip_configuration {
+    name                                               = "gateway"
+    gateway_load_balancer_frontend_ip_configuration_id = azurerm_lb.standard.frontend_ip_configuration.0.id
+    private_ip_address_allocation                      = "Dynamic"
+    subnet_id                                          = azurerm_subnet.test.id
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
 func (NetworkInterfaceResource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -132,6 +133,34 @@ func dataSourceNetworkSecurityGroup() *pluginsdk.Resource {
 				},
 			},
 
+			"flow_log": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"enabled": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+
+						"storage_account_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"version": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+
 			"tags": tags.SchemaDataSource(),
 		},
 	}
@@ -169,7 +198,49 @@ func dataSourceNetworkSecurityGroupRead(d *pluginsdk.ResourceData, meta interfac
 		if err := d.Set("security_rule", flattenedRules); err != nil {
 			return fmt.Errorf("setting `security_rule`: %+v", err)
 		}
+
+		if err := d.Set("flow_log", flattenNetworkSecurityGroupFlowLogs(props.FlowLogs)); err != nil {
+			return fmt.Errorf("setting `flow_log`: %+v", err)
+		}
 	}
 
 	return tags.FlattenAndSet(d, resp.Tags)
 }
+
+func flattenNetworkSecurityGroupFlowLogs(input *[]network.FlowLog) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		id := ""
+		if item.ID != nil {
+			id = *item.ID
+		}
+
+		enabled := false
+		storageAccountId := ""
+		version := 0
+		if props := item.FlowLogPropertiesFormat; props != nil {
+			if props.Enabled != nil {
+				enabled = *props.Enabled
+			}
+			if props.StorageID != nil {
+				storageAccountId = *props.StorageID
+			}
+			if props.Format != nil && props.Format.Version != nil {
+				version = int(*props.Format.Version)
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"id":                 id,
+			"enabled":            enabled,
+			"storage_account_id": storageAccountId,
+			"version":            version,
+		})
+	}
+
+	return results
+}
@@ -20,6 +20,7 @@ func TestAccDataSourceNetworkSecurityGroup_basic(t *testing.T) {
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).Key("location").Exists(),
 				check.That(data.ResourceName).Key("security_rule.#").HasValue("0"),
+				check.That(data.ResourceName).Key("flow_log.#").HasValue("0"),
 				check.That(data.ResourceName).Key("tags.%").HasValue("0"),
 			),
 		},
@@ -68,6 +69,24 @@ func TestAccDataSourceNetworkSecurityGroup_tags(t *testing.T) {
 	})
 }
 
+func testAccDataSourceNetworkSecurityGroup_flowLog(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_network_security_group", "test")
+	r := NetworkSecurityGroupDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.flowLog(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("flow_log.#").HasValue("1"),
+				check.That(data.ResourceName).Key("flow_log.0.id").Exists(),
+				check.That(data.ResourceName).Key("flow_log.0.enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("flow_log.0.storage_account_id").Exists(),
+				check.That(data.ResourceName).Key("flow_log.0.version").HasValue("2"),
+			),
+		},
+	})
+}
+
 func (NetworkSecurityGroupDataSource) basic(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -155,3 +174,16 @@ data "azurerm_network_security_group" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
 }
+
+func (NetworkSecurityGroupDataSource) flowLog(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_network_security_group" "test" {
+  name                = azurerm_network_security_group.test.name
+  resource_group_name = azurerm_resource_group.test.name
+
+  depends_on = [azurerm_network_watcher_flow_log.test]
+}
+`, NetworkWatcherFlowLogResource{}.versionConfig(data, 2))
+}
@@ -33,7 +33,8 @@ func TestAccNetworkWatcher(t *testing.T) {
 			"disappears":     testAccNetworkWatcher_disappears,
 		},
 		"DataSource": {
-			"basic": testAccDataSourceNetworkWatcher_basic,
+			"basic":                           testAccDataSourceNetworkWatcher_basic,
+			"networkSecurityGroupWithFlowLog": testAccDataSourceNetworkSecurityGroup_flowLog,
 		},
 		"PacketCaptureOld": {
 			"localDisk":                  testAccPacketCapture_localDisk,
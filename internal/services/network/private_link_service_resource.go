@@ -109,7 +109,7 @@ func resourcePrivateLinkService() *pluginsdk.Resource {
 						"subnet_id": {
 							Type:         pluginsdk.TypeString,
 							Required:     true,
-							ValidateFunc: azure.ValidateResourceID,
+							ValidateFunc: networkValidate.SubnetID,
 						},
 						"primary": {
 							Type:     pluginsdk.TypeBool,
@@ -448,6 +448,8 @@ func validatePrivateLinkNatIpConfiguration(d *pluginsdk.ResourceDiff) error {
 	resourceGroup := d.Get("resource_group_name").(string)
 	ipConfigurations := d.Get("nat_ip_configuration").([]interface{})
 
+	primaryCount := 0
+	primaryKnown := true
 	for i, item := range ipConfigurations {
 		v := item.(map[string]interface{})
 		p := fmt.Sprintf("nat_ip_configuration.%d.private_ip_address", i)
@@ -455,6 +457,13 @@ func validatePrivateLinkNatIpConfiguration(d *pluginsdk.ResourceDiff) error {
 		isPrimary := v["primary"].(bool)
 		in := v["name"].(string)
 
+		if !d.NewValueKnown(fmt.Sprintf("nat_ip_configuration.%d.primary", i)) {
+			primaryKnown = false
+		}
+		if isPrimary {
+			primaryCount++
+		}
+
 		if d.HasChange(p) {
 			o, n := d.GetChange(p)
 			if o != "" && n == "" {
@@ -470,5 +479,9 @@ func validatePrivateLinkNatIpConfiguration(d *pluginsdk.ResourceDiff) error {
 		}
 	}
 
+	if primaryKnown && len(ipConfigurations) > 0 && primaryCount != 1 {
+		return fmt.Errorf("Private Link Service %q (Resource Group %q) must have exactly one primary nat_ip_configuration but got %d", name, resourceGroup, primaryCount)
+	}
+
 	return nil
 }
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
@@ -84,6 +85,13 @@ func resourcePublicIpPrefix() *pluginsdk.Resource {
 					}, !features.ThreePointOh()),
 				},
 
+				"custom_ip_prefix_id": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validate.CustomIpPrefixID,
+				},
+
 				"ip_prefix": {
 					Type:     pluginsdk.TypeString,
 					Computed: true,
@@ -145,7 +153,7 @@ func resourcePublicIpPrefixCreateUpdate(d *pluginsdk.ResourceData, meta interfac
 
 	id := parse.NewPublicIpPrefixID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
 	if d.IsNewResource() {
-		existing, err := client.Get(ctx, id.ResourceGroup, id.PublicIPPrefixeName, "")
+		existing, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
 				return fmt.Errorf("checking for presence of existing %s: %s", id, err)
@@ -175,6 +183,12 @@ func resourcePublicIpPrefixCreateUpdate(d *pluginsdk.ResourceData, meta interfac
 		Tags: tags.Expand(t),
 	}
 
+	if v, ok := d.GetOk("custom_ip_prefix_id"); ok {
+		publicIpPrefix.PublicIPPrefixPropertiesFormat.CustomIPPrefix = &network.SubResource{
+			ID: utils.String(v.(string)),
+		}
+	}
+
 	if features.ThreePointOhBeta() {
 		zones := zones.Expand(d.Get("zones").(*schema.Set).List())
 		if len(zones) > 0 {
@@ -203,7 +217,7 @@ func resourcePublicIpPrefixCreateUpdate(d *pluginsdk.ResourceData, meta interfac
 		publicIpPrefix.Zones = zones
 	}
 
-	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.PublicIPPrefixeName, publicIpPrefix)
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, publicIpPrefix)
 	if err != nil {
 		return fmt.Errorf("creating/Updating %s: %+v", id, err)
 	}
@@ -227,7 +241,7 @@ func resourcePublicIpPrefixRead(d *pluginsdk.ResourceData, meta interface{}) err
 		return err
 	}
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.PublicIPPrefixeName, "")
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			d.SetId("")
@@ -237,7 +251,7 @@ func resourcePublicIpPrefixRead(d *pluginsdk.ResourceData, meta interface{}) err
 		return fmt.Errorf("making Read request on %s: %+v", *id, err)
 	}
 
-	d.Set("name", id.PublicIPPrefixeName)
+	d.Set("name", id.Name)
 	d.Set("resource_group_name", id.ResourceGroup)
 
 	d.Set("location", location.NormalizeNilable(resp.Location))
@@ -272,6 +286,16 @@ func resourcePublicIpPrefixRead(d *pluginsdk.ResourceData, meta interface{}) err
 		d.Set("prefix_length", props.PrefixLength)
 		d.Set("ip_prefix", props.IPPrefix)
 
+		customIpPrefixId := ""
+		if props.CustomIPPrefix != nil && props.CustomIPPrefix.ID != nil {
+			parsed, err := parse.CustomIpPrefixID(*props.CustomIPPrefix.ID)
+			if err != nil {
+				return err
+			}
+			customIpPrefixId = parsed.ID()
+		}
+		d.Set("custom_ip_prefix_id", customIpPrefixId)
+
 		if version := props.PublicIPAddressVersion; version != "" {
 			d.Set("ip_version", string(props.PublicIPAddressVersion))
 		}
@@ -290,7 +314,7 @@ func resourcePublicIpPrefixDelete(d *pluginsdk.ResourceData, meta interface{}) e
 		return err
 	}
 
-	future, err := client.Delete(ctx, id.ResourceGroup, id.PublicIPPrefixeName)
+	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return fmt.Errorf("deleting %s: %+v", *id, err)
 	}
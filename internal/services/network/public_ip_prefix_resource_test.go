@@ -23,7 +23,7 @@ func (t PublicIPPrefixResource) Exists(ctx context.Context, clients *clients.Cli
 		return nil, err
 	}
 
-	resp, err := clients.Network.PublicIPPrefixesClient.Get(ctx, id.ResourceGroup, id.PublicIPPrefixeName, "")
+	resp, err := clients.Network.PublicIPPrefixesClient.Get(ctx, id.ResourceGroup, id.Name, "")
 	if err != nil {
 		return nil, fmt.Errorf("reading Public IP Prefix (%s): %+v", id, err)
 	}
@@ -37,7 +37,7 @@ func (PublicIPPrefixResource) Destroy(ctx context.Context, client *clients.Clien
 		return nil, err
 	}
 
-	future, err := client.Network.PublicIPPrefixesClient.Delete(ctx, id.ResourceGroup, id.PublicIPPrefixeName)
+	future, err := client.Network.PublicIPPrefixesClient.Delete(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("deleting Public IP Prefix %q: %+v", id, err)
 	}
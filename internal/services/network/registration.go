@@ -57,6 +57,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_application_gateway":                      resourceApplicationGateway(),
 		"azurerm_application_security_group":               resourceApplicationSecurityGroup(),
 		"azurerm_bastion_host":                             resourceBastionHost(),
+		"azurerm_custom_ip_prefix":                         resourceCustomIpPrefix(),
 		"azurerm_express_route_circuit_connection":         resourceExpressRouteCircuitConnection(),
 		"azurerm_express_route_circuit_authorization":      resourceExpressRouteCircuitAuthorization(),
 		"azurerm_express_route_circuit_peering":            resourceExpressRouteCircuitPeering(),
@@ -92,6 +93,8 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_network_watcher_flow_log":                  resourceNetworkWatcherFlowLog(),
 		"azurerm_network_watcher":                           resourceNetworkWatcher(),
 		"azurerm_route_filter":                              resourceRouteFilter(),
+		"azurerm_route_server":                              resourceRouteServer(),
+		"azurerm_route_server_bgp_connection":               resourceRouteServerBgpConnection(),
 		"azurerm_route_table":                               resourceRouteTable(),
 		"azurerm_route":                                     resourceRoute(),
 		"azurerm_virtual_hub_security_partner_provider":     resourceVirtualHubSecurityPartnerProvider(),
@@ -104,8 +107,10 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_virtual_hub_bgp_connection":                resourceVirtualHubBgpConnection(),
 		"azurerm_virtual_hub_connection":                    resourceVirtualHubConnection(),
 		"azurerm_virtual_hub_ip":                            resourceVirtualHubIP(),
+		"azurerm_virtual_hub_network_virtual_appliance":     resourceVirtualHubNetworkVirtualAppliance(),
 		"azurerm_virtual_hub_route_table":                   resourceVirtualHubRouteTable(),
 		"azurerm_virtual_hub_route_table_route":             resourceVirtualHubRouteTableRoute(),
+		"azurerm_virtual_hub_routing_intent":                resourceVirtualHubRoutingIntent(),
 		"azurerm_virtual_network_dns_servers":               resourceVirtualNetworkDnsServers(),
 		"azurerm_virtual_network_gateway_connection":        resourceVirtualNetworkGatewayConnection(),
 		"azurerm_virtual_network_gateway":                   resourceVirtualNetworkGateway(),
@@ -0,0 +1,89 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type RouteServerBgpConnectionResource struct{}
+
+func TestAccRouteServerBgpConnection_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_route_server_bgp_connection", "test")
+	r := RouteServerBgpConnectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccRouteServerBgpConnection_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_route_server_bgp_connection", "test")
+	r := RouteServerBgpConnectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (RouteServerBgpConnectionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.RouteServerBgpConnectionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.VirtualHubBgpConnectionClient.Get(ctx, id.ResourceGroup, id.VirtualHubName, id.BgpConnectionName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.BgpConnectionProperties != nil), nil
+}
+
+func (RouteServerBgpConnectionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_route_server_bgp_connection" "test" {
+  name            = "acctest-rs-bgpconnection-%d"
+  route_server_id = azurerm_route_server.test.id
+  peer_asn        = 65501
+  peer_ip         = "10.0.2.4"
+}
+`, RouteServerResource{}.basic(data), data.RandomInteger)
+}
+
+func (r RouteServerBgpConnectionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_route_server_bgp_connection" "import" {
+  name            = azurerm_route_server_bgp_connection.test.name
+  route_server_id = azurerm_route_server_bgp_connection.test.route_server_id
+  peer_asn        = azurerm_route_server_bgp_connection.test.peer_asn
+  peer_ip         = azurerm_route_server_bgp_connection.test.peer_ip
+}
+`, r.basic(data))
+}
@@ -109,38 +109,9 @@ func resourceSubnet() *pluginsdk.Resource {
 							Elem: &pluginsdk.Resource{
 								Schema: map[string]*pluginsdk.Schema{
 									"name": {
-										Type:     pluginsdk.TypeString,
-										Required: true,
-										ValidateFunc: validation.StringInSlice([]string{
-											"Microsoft.ApiManagement/service",
-											"Microsoft.AzureCosmosDB/clusters",
-											"Microsoft.BareMetal/AzureVMware",
-											"Microsoft.BareMetal/CrayServers",
-											"Microsoft.Batch/batchAccounts",
-											"Microsoft.ContainerInstance/containerGroups",
-											"Microsoft.ContainerService/managedClusters",
-											"Microsoft.Databricks/workspaces",
-											"Microsoft.DBforMySQL/flexibleServers",
-											"Microsoft.DBforMySQL/serversv2",
-											"Microsoft.DBforPostgreSQL/flexibleServers",
-											"Microsoft.DBforPostgreSQL/serversv2",
-											"Microsoft.DBforPostgreSQL/singleServers",
-											"Microsoft.HardwareSecurityModules/dedicatedHSMs",
-											"Microsoft.Kusto/clusters",
-											"Microsoft.Logic/integrationServiceEnvironments",
-											"Microsoft.MachineLearningServices/workspaces",
-											"Microsoft.Netapp/volumes",
-											"Microsoft.Network/managedResolvers",
-											"Microsoft.PowerPlatform/vnetaccesslinks",
-											"Microsoft.ServiceFabricMesh/networks",
-											"Microsoft.Sql/managedInstances",
-											"Microsoft.Sql/servers",
-											"Microsoft.StoragePool/diskPools",
-											"Microsoft.StreamAnalytics/streamingJobs",
-											"Microsoft.Synapse/workspaces",
-											"Microsoft.Web/hostingEnvironments",
-											"Microsoft.Web/serverFarms",
-										}, false),
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validate.SubnetDelegationServiceName,
 									},
 
 									"actions": {
@@ -3,6 +3,7 @@ package network_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
@@ -155,6 +156,18 @@ func TestAccSubnet_delegation(t *testing.T) {
 	})
 }
 
+func TestAccSubnet_delegationInvalidServiceName(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_subnet", "test")
+	r := SubnetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.delegationServiceName(data, "Microsoft.Web/serverfarms"),
+			ExpectError: regexp.MustCompile(`did you mean "Microsoft.Web/serverFarms"`),
+		},
+	})
+}
+
 func TestAccSubnet_enforcePrivateLinkEndpointNetworkPolicies(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_subnet", "test")
 	r := SubnetResource{}
@@ -455,6 +468,27 @@ resource "azurerm_subnet" "test" {
 `, r.template(data))
 }
 
+func (r SubnetResource) delegationServiceName(data acceptance.TestData, serviceName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_subnet" "test" {
+  name                 = "internal"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefix       = "10.0.2.0/24"
+
+  delegation {
+    name = "first"
+
+    service_delegation {
+      name = "%s"
+    }
+  }
+}
+`, r.template(data), serviceName)
+}
+
 func (r SubnetResource) delegationUpdated(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
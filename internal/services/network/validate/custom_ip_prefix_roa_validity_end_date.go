@@ -0,0 +1,22 @@
+package validate
+
+import (
+	"fmt"
+	"time"
+)
+
+// CustomIpPrefixRoaValidityEndDate validates the expiration date of a Route Origin Authorization,
+// which is specified in the format `YYYY-MM-DD`
+func CustomIpPrefixRoaValidityEndDate(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := time.Parse("2006-01-02", v); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a date in the format `YYYY-MM-DD`, got %q", k, v))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,39 @@
+package validate
+
+import "testing"
+
+func TestCustomIpPrefixRoaValidityEndDate(t *testing.T) {
+	testCases := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			Input:    "",
+			Expected: false,
+		},
+		{
+			Input:    "2099-12-12",
+			Expected: true,
+		},
+		{
+			Input:    "2099-02-30",
+			Expected: false,
+		},
+		{
+			Input:    "20991212",
+			Expected: false,
+		},
+		{
+			Input:    "2099-12-12T00:00:00Z",
+			Expected: false,
+		},
+	}
+
+	for _, v := range testCases {
+		_, errors := CustomIpPrefixRoaValidityEndDate(v.Input, "roa_validity_end_date")
+		result := len(errors) == 0
+		if result != v.Expected {
+			t.Fatalf("Expected the result to be %t for %q but got %t (and %d errors)", v.Expected, v.Input, result, len(errors))
+		}
+	}
+}
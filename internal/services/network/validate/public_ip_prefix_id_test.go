@@ -41,13 +41,13 @@ func TestPublicIpPrefixID(t *testing.T) {
 		},
 
 		{
-			// missing PublicIPPrefixeName
+			// missing Name
 			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/",
 			Valid: false,
 		},
 
 		{
-			// missing value for PublicIPPrefixeName
+			// missing value for Name
 			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/publicIPPrefixes/",
 			Valid: false,
 		},
@@ -0,0 +1,29 @@
+package validate
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+)
+
+// RouteServerSubnetID validates that the Subnet ID is for a Subnet named `RouteServerSubnet`,
+// which is the only Subnet a Route Server can be deployed into
+func RouteServerSubnetID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	id, err := parse.SubnetID(v)
+	if err != nil {
+		errors = append(errors, err)
+		return
+	}
+
+	if id.Name != "RouteServerSubnet" {
+		errors = append(errors, fmt.Errorf("%q must reference a Subnet named `RouteServerSubnet`, got %q", key, id.Name))
+	}
+
+	return
+}
@@ -0,0 +1,93 @@
+package validate
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SubnetDelegationServiceNames is the list of services which a Subnet can be delegated to - new services
+// should be added here in alphabetical order
+var SubnetDelegationServiceNames = []string{
+	"Microsoft.ApiManagement/service",
+	"Microsoft.AzureCosmosDB/clusters",
+	"Microsoft.BareMetal/AzureVMware",
+	"Microsoft.BareMetal/CrayServers",
+	"Microsoft.Batch/batchAccounts",
+	"Microsoft.ContainerInstance/containerGroups",
+	"Microsoft.ContainerService/managedClusters",
+	"Microsoft.Databricks/workspaces",
+	"Microsoft.DBforMySQL/flexibleServers",
+	"Microsoft.DBforMySQL/serversv2",
+	"Microsoft.DBforPostgreSQL/flexibleServers",
+	"Microsoft.DBforPostgreSQL/serversv2",
+	"Microsoft.DBforPostgreSQL/singleServers",
+	"Microsoft.HardwareSecurityModules/dedicatedHSMs",
+	"Microsoft.Kusto/clusters",
+	"Microsoft.Logic/integrationServiceEnvironments",
+	"Microsoft.MachineLearningServices/workspaces",
+	"Microsoft.Netapp/volumes",
+	"Microsoft.Network/managedResolvers",
+	"Microsoft.PowerPlatform/vnetaccesslinks",
+	"Microsoft.ServiceFabricMesh/networks",
+	"Microsoft.Sql/managedInstances",
+	"Microsoft.Sql/servers",
+	"Microsoft.StoragePool/diskPools",
+	"Microsoft.StreamAnalytics/streamingJobs",
+	"Microsoft.Synapse/workspaces",
+	"Microsoft.Web/hostingEnvironments",
+	"Microsoft.Web/serverFarms",
+}
+
+// SubnetDelegationServiceName validates the name of the service a Subnet is delegated to, suggesting
+// the closest known service names when there's no exact match
+func SubnetDelegationServiceName(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	for _, name := range SubnetDelegationServiceNames {
+		if v == name {
+			return
+		}
+	}
+
+	if suggestions := subnetDelegationServiceNameSuggestions(v); len(suggestions) > 0 {
+		errors = append(errors, fmt.Errorf("%q must be a known delegation service name, got %q - did you mean %s?", k, v, strings.Join(suggestions, " or ")))
+		return
+	}
+
+	errors = append(errors, fmt.Errorf("%q must be a known delegation service name, got %q - possible values are %s", k, v, strings.Join(SubnetDelegationServiceNames, ", ")))
+	return warnings, errors
+}
+
+// subnetDelegationServiceNameSuggestions returns the known service names which differ from the input only
+// by casing, or which share either the Resource Provider or the Resource Type with the input
+func subnetDelegationServiceNameSuggestions(input string) []string {
+	for _, name := range SubnetDelegationServiceNames {
+		if strings.EqualFold(input, name) {
+			return []string{fmt.Sprintf("%q", name)}
+		}
+	}
+
+	inputProvider, inputType := splitSubnetDelegationServiceName(input)
+	suggestions := make([]string, 0)
+	for _, name := range SubnetDelegationServiceNames {
+		provider, resourceType := splitSubnetDelegationServiceName(name)
+		if strings.EqualFold(inputProvider, provider) || (inputType != "" && strings.EqualFold(inputType, resourceType)) {
+			suggestions = append(suggestions, fmt.Sprintf("%q", name))
+		}
+	}
+
+	return suggestions
+}
+
+func splitSubnetDelegationServiceName(input string) (string, string) {
+	segments := strings.SplitN(input, "/", 2)
+	if len(segments) == 1 {
+		return segments[0], ""
+	}
+
+	return segments[0], segments[1]
+}
@@ -0,0 +1,77 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSubnetDelegationServiceName(t *testing.T) {
+	testCases := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			Input:    "",
+			Expected: false,
+		},
+		{
+			Input:    "Microsoft.Web/serverFarms",
+			Expected: true,
+		},
+		{
+			Input:    "Microsoft.ContainerInstance/containerGroups",
+			Expected: true,
+		},
+		{
+			Input:    "Microsoft.Web/serverfarms",
+			Expected: false,
+		},
+		{
+			Input:    "Microsoft.Web/serverFarm",
+			Expected: false,
+		},
+		{
+			Input:    "Microsoft.Example/widgets",
+			Expected: false,
+		},
+	}
+
+	for _, v := range testCases {
+		_, errors := SubnetDelegationServiceName(v.Input, "name")
+		result := len(errors) == 0
+		if result != v.Expected {
+			t.Fatalf("Expected the result to be %t for %q but got %t (and %d errors)", v.Expected, v.Input, result, len(errors))
+		}
+	}
+}
+
+func TestSubnetDelegationServiceNameSuggestions(t *testing.T) {
+	testCases := []struct {
+		Input    string
+		Expected []string
+	}{
+		{
+			Input:    "Microsoft.Web/serverfarms",
+			Expected: []string{`"Microsoft.Web/serverFarms"`},
+		},
+		{
+			Input:    "Microsoft.Web/serverFarm",
+			Expected: []string{`"Microsoft.Web/hostingEnvironments"`, `"Microsoft.Web/serverFarms"`},
+		},
+		{
+			Input:    "Microsoft.Containerinstances/containerGroups",
+			Expected: []string{`"Microsoft.ContainerInstance/containerGroups"`},
+		},
+		{
+			Input:    "Microsoft.Example/widgets",
+			Expected: []string{},
+		},
+	}
+
+	for _, v := range testCases {
+		actual := subnetDelegationServiceNameSuggestions(v.Input)
+		if strings.Join(actual, ",") != strings.Join(v.Expected, ",") {
+			t.Fatalf("Expected the suggestions for %q to be %v but got %v", v.Input, v.Expected, actual)
+		}
+	}
+}
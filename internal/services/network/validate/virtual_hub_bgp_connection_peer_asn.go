@@ -0,0 +1,31 @@
+package validate
+
+import (
+	"fmt"
+)
+
+// VirtualHubBgpConnectionPeerASN validates the ASN of a BGP peer, which must be a valid 32-bit ASN
+// and must not be one of the ASNs reserved by Azure
+func VirtualHubBgpConnectionPeerASN(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(int)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be int", k))
+		return
+	}
+
+	if v < 1 || int64(v) > 4294967295 {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 4294967295, got %d", k, v))
+		return
+	}
+
+	// https://docs.microsoft.com/azure/virtual-wan/scenario-bgp-peering-hub#bgp-peering-restrictions
+	reserved := []int{8074, 8075, 12076, 65515, 65516, 65517, 65518, 65519, 65520}
+	for _, r := range reserved {
+		if v == r {
+			errors = append(errors, fmt.Errorf("%q cannot be %d since this ASN is reserved by Azure", k, v))
+			return
+		}
+	}
+
+	return warnings, errors
+}
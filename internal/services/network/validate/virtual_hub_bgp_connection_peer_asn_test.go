@@ -0,0 +1,64 @@
+package validate
+
+import "testing"
+
+func TestVirtualHubBgpConnectionPeerASN(t *testing.T) {
+	testCases := []struct {
+		Input    int64
+		Expected bool
+	}{
+		{
+			Input:    0,
+			Expected: false,
+		},
+		{
+			Input:    1,
+			Expected: true,
+		},
+		{
+			Input:    8074,
+			Expected: false,
+		},
+		{
+			Input:    12076,
+			Expected: false,
+		},
+		{
+			Input:    65514,
+			Expected: true,
+		},
+		{
+			Input:    65515,
+			Expected: false,
+		},
+		{
+			Input:    65520,
+			Expected: false,
+		},
+		{
+			Input:    65521,
+			Expected: true,
+		},
+		{
+			Input:    4294967295,
+			Expected: true,
+		},
+		{
+			Input:    4294967296,
+			Expected: false,
+		},
+	}
+
+	for _, v := range testCases {
+		// values which don't fit within an int can't be passed on 32-bit platforms
+		if int64(int(v.Input)) != v.Input {
+			continue
+		}
+
+		_, errors := VirtualHubBgpConnectionPeerASN(int(v.Input), "peer_asn")
+		result := len(errors) == 0
+		if result != v.Expected {
+			t.Fatalf("Expected the result to be %t for %d but got %t (and %d errors)", v.Expected, v.Input, result, len(errors))
+		}
+	}
+}
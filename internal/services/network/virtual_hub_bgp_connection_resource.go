@@ -53,7 +53,7 @@ func resourceVirtualHubBgpConnection() *pluginsdk.Resource {
 				Type:         pluginsdk.TypeInt,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: validation.IntAtLeast(0),
+				ValidateFunc: validate.VirtualHubBgpConnectionPeerASN,
 			},
 
 			"peer_ip": {
@@ -62,6 +62,13 @@ func resourceVirtualHubBgpConnection() *pluginsdk.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.IsIPv4Address,
 			},
+
+			"virtual_network_connection_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.HubVirtualNetworkConnectionID,
+			},
 		},
 	}
 }
@@ -102,6 +109,12 @@ func resourceVirtualHubBgpConnectionCreate(d *pluginsdk.ResourceData, meta inter
 		},
 	}
 
+	if v, ok := d.GetOk("virtual_network_connection_id"); ok {
+		parameters.BgpConnectionProperties.HubVirtualNetworkConnection = &network.SubResource{
+			ID: utils.String(v.(string)),
+		}
+	}
+
 	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.VirtualHubName, id.Name, parameters)
 	if err != nil {
 		return fmt.Errorf("creating/updating %s: %+v", id, err)
@@ -143,6 +156,16 @@ func resourceVirtualHubBgpConnectionRead(d *pluginsdk.ResourceData, meta interfa
 	if props := resp.BgpConnectionProperties; props != nil {
 		d.Set("peer_asn", props.PeerAsn)
 		d.Set("peer_ip", props.PeerIP)
+
+		virtualNetworkConnectionId := ""
+		if props.HubVirtualNetworkConnection != nil && props.HubVirtualNetworkConnection.ID != nil {
+			connectionId, err := parse.HubVirtualNetworkConnectionID(*props.HubVirtualNetworkConnection.ID)
+			if err != nil {
+				return err
+			}
+			virtualNetworkConnectionId = connectionId.ID()
+		}
+		d.Set("virtual_network_connection_id", virtualNetworkConnectionId)
 	}
 
 	return nil
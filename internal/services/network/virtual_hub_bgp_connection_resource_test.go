@@ -43,6 +43,21 @@ func TestAccVirtualHubBgpConnection_requiresImport(t *testing.T) {
 	})
 }
 
+func TestAccVirtualHubBgpConnection_virtualNetworkConnection(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_hub_bgp_connection", "test")
+	r := VirtualHubBGPConnectionResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.virtualNetworkConnection(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("virtual_network_connection_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (t VirtualHubBGPConnectionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.BgpConnectionID(state.ID)
 	if err != nil {
@@ -135,3 +150,51 @@ resource "azurerm_virtual_hub_bgp_connection" "import" {
 }
 `, r.basic(data))
 }
+
+func (VirtualHubBGPConnectionResource) virtualNetworkConnection(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-VHub-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_wan" "test" {
+  name                = "acctest-VWAN-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_virtual_hub" "test" {
+  name                = "acctest-VHub-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  virtual_wan_id      = azurerm_virtual_wan.test.id
+  address_prefix      = "10.0.0.0/23"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctest-VNet-%[1]d"
+  address_space       = ["10.5.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_virtual_hub_connection" "test" {
+  name                      = "acctest-VHubConn-%[1]d"
+  virtual_hub_id            = azurerm_virtual_hub.test.id
+  remote_virtual_network_id = azurerm_virtual_network.test.id
+}
+
+resource "azurerm_virtual_hub_bgp_connection" "test" {
+  name                          = "acctest-VHub-BgpConnection-%[1]d"
+  virtual_hub_id                = azurerm_virtual_hub.test.id
+  peer_asn                      = 65514
+  peer_ip                       = "10.5.1.4"
+  virtual_network_connection_id = azurerm_virtual_hub_connection.test.id
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
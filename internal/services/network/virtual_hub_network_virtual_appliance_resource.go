@@ -0,0 +1,322 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceVirtualHubNetworkVirtualAppliance() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceVirtualHubNetworkVirtualApplianceCreate,
+		Read:   resourceVirtualHubNetworkVirtualApplianceRead,
+		Update: resourceVirtualHubNetworkVirtualApplianceUpdate,
+		Delete: resourceVirtualHubNetworkVirtualApplianceDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.NetworkVirtualApplianceID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": azure.SchemaLocation(),
+
+			"virtual_hub_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: networkValidate.VirtualHubID,
+			},
+
+			"vendor": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"scale_unit": {
+				Type:         pluginsdk.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"version": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"virtual_appliance_asn": {
+				Type:         pluginsdk.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: networkValidate.VirtualHubBgpConnectionPeerASN,
+			},
+
+			"cloud_init_configuration": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceVirtualHubNetworkVirtualApplianceCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VirtualAppliancesClient
+	skusClient := meta.(*clients.Client).Network.VirtualApplianceSkusClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewNetworkVirtualApplianceID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+
+	virtualHubId, err := parse.VirtualHubID(d.Get("virtual_hub_id").(string))
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(virtualHubId.Name, virtualHubResourceName)
+	defer locks.UnlockByName(virtualHubId.Name, virtualHubResourceName)
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_virtual_hub_network_virtual_appliance", id.ID())
+	}
+
+	vendor := d.Get("vendor").(string)
+	scaleUnit := strconv.Itoa(d.Get("scale_unit").(int))
+	version := d.Get("version").(string)
+	if err := validateVirtualHubNetworkVirtualApplianceSku(ctx, skusClient, vendor, scaleUnit, version); err != nil {
+		return err
+	}
+
+	parameters := network.VirtualAppliance{
+		Location: utils.String(azure.NormalizeLocation(d.Get("location").(string))),
+		VirtualAppliancePropertiesFormat: &network.VirtualAppliancePropertiesFormat{
+			NvaSku: &network.VirtualApplianceSkuProperties{
+				Vendor:             utils.String(vendor),
+				BundledScaleUnit:   utils.String(scaleUnit),
+				MarketPlaceVersion: utils.String(version),
+			},
+			VirtualHub: &network.SubResource{
+				ID: utils.String(virtualHubId.ID()),
+			},
+			VirtualApplianceAsn: utils.Int64(int64(d.Get("virtual_appliance_asn").(int))),
+		},
+		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("cloud_init_configuration"); ok {
+		parameters.VirtualAppliancePropertiesFormat.CloudInitConfiguration = utils.String(v.(string))
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, parameters)
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceVirtualHubNetworkVirtualApplianceRead(d, meta)
+}
+
+func resourceVirtualHubNetworkVirtualApplianceRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VirtualAppliancesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NetworkVirtualApplianceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azure.NormalizeLocation(*location))
+	}
+
+	if props := resp.VirtualAppliancePropertiesFormat; props != nil {
+		virtualHubId := ""
+		if props.VirtualHub != nil && props.VirtualHub.ID != nil {
+			hubId, err := parse.VirtualHubID(*props.VirtualHub.ID)
+			if err != nil {
+				return err
+			}
+			virtualHubId = hubId.ID()
+		}
+		d.Set("virtual_hub_id", virtualHubId)
+
+		if sku := props.NvaSku; sku != nil {
+			d.Set("vendor", sku.Vendor)
+			d.Set("version", sku.MarketPlaceVersion)
+
+			scaleUnit := 0
+			if sku.BundledScaleUnit != nil {
+				scaleUnit, err = strconv.Atoi(*sku.BundledScaleUnit)
+				if err != nil {
+					return fmt.Errorf("parsing `scale_unit` %q: %+v", *sku.BundledScaleUnit, err)
+				}
+			}
+			d.Set("scale_unit", scaleUnit)
+		}
+
+		virtualApplianceAsn := 0
+		if props.VirtualApplianceAsn != nil {
+			virtualApplianceAsn = int(*props.VirtualApplianceAsn)
+		}
+		d.Set("virtual_appliance_asn", virtualApplianceAsn)
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceVirtualHubNetworkVirtualApplianceUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VirtualAppliancesClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NetworkVirtualApplianceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("tags") {
+		parameters := network.TagsObject{
+			Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		}
+		if _, err := client.UpdateTags(ctx, id.ResourceGroup, id.Name, parameters); err != nil {
+			return fmt.Errorf("updating tags for %s: %+v", *id, err)
+		}
+	}
+
+	return resourceVirtualHubNetworkVirtualApplianceRead(d, meta)
+}
+
+func resourceVirtualHubNetworkVirtualApplianceDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VirtualAppliancesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NetworkVirtualApplianceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	virtualHubId, err := parse.VirtualHubID(d.Get("virtual_hub_id").(string))
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(virtualHubId.Name, virtualHubResourceName)
+	defer locks.UnlockByName(virtualHubId.Name, virtualHubResourceName)
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+		}
+	}
+
+	return nil
+}
+
+// validateVirtualHubNetworkVirtualApplianceSku checks the vendor, scale unit and version are offered by Azure,
+// since the API otherwise only fails once the Network Virtual Appliance is being provisioned
+func validateVirtualHubNetworkVirtualApplianceSku(ctx context.Context, client *network.VirtualApplianceSkusClient, vendor, scaleUnit, version string) error {
+	sku, err := client.Get(ctx, vendor)
+	if err != nil {
+		if utils.ResponseWasNotFound(sku.Response) {
+			return fmt.Errorf("the `vendor` %q isn't a supported Network Virtual Appliance vendor", vendor)
+		}
+		return fmt.Errorf("retrieving Network Virtual Appliance SKU %q: %+v", vendor, err)
+	}
+
+	props := sku.VirtualApplianceSkuPropertiesFormat
+	if props == nil {
+		return nil
+	}
+
+	if props.AvailableScaleUnits != nil {
+		availableScaleUnits := make([]string, 0)
+		for _, v := range *props.AvailableScaleUnits {
+			if v.ScaleUnit != nil {
+				availableScaleUnits = append(availableScaleUnits, *v.ScaleUnit)
+			}
+		}
+
+		if !utils.SliceContainsValue(availableScaleUnits, scaleUnit) {
+			return fmt.Errorf("the `scale_unit` %s isn't available for the vendor %q - possible values are %s", scaleUnit, vendor, strings.Join(availableScaleUnits, ", "))
+		}
+	}
+
+	if props.AvailableVersions != nil && !utils.SliceContainsValue(*props.AvailableVersions, version) {
+		return fmt.Errorf("the `version` %q isn't available for the vendor %q - possible values are %s", version, vendor, strings.Join(*props.AvailableVersions, ", "))
+	}
+
+	return nil
+}
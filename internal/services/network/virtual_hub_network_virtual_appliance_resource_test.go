@@ -0,0 +1,195 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type VirtualHubNetworkVirtualApplianceResource struct{}
+
+func TestAccVirtualHubNetworkVirtualAppliance_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_hub_network_virtual_appliance", "test")
+	r := VirtualHubNetworkVirtualApplianceResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("cloud_init_configuration"),
+	})
+}
+
+func TestAccVirtualHubNetworkVirtualAppliance_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_hub_network_virtual_appliance", "test")
+	r := VirtualHubNetworkVirtualApplianceResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccVirtualHubNetworkVirtualAppliance_updateTags(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_hub_network_virtual_appliance", "test")
+	r := VirtualHubNetworkVirtualApplianceResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("cloud_init_configuration"),
+		{
+			Config: r.withTags(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("tags.%").HasValue("1"),
+			),
+		},
+		data.ImportStep("cloud_init_configuration"),
+	})
+}
+
+func TestAccVirtualHubNetworkVirtualAppliance_invalidScaleUnit(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_hub_network_virtual_appliance", "test")
+	r := VirtualHubNetworkVirtualApplianceResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.invalidScaleUnit(data),
+			ExpectError: regexp.MustCompile("the `scale_unit` 3 isn't available for the vendor"),
+		},
+	})
+}
+
+func (r VirtualHubNetworkVirtualApplianceResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.NetworkVirtualApplianceID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.VirtualAppliancesClient.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.VirtualAppliancePropertiesFormat != nil), nil
+}
+
+func (r VirtualHubNetworkVirtualApplianceResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-nva-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_wan" "test" {
+  name                = "acctest-vwan-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_virtual_hub" "test" {
+  name                = "acctest-vhub-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  virtual_wan_id      = azurerm_virtual_wan.test.id
+  address_prefix      = "10.0.1.0/24"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r VirtualHubNetworkVirtualApplianceResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_hub_network_virtual_appliance" "test" {
+  name                  = "acctest-nva-%d"
+  resource_group_name   = azurerm_resource_group.test.name
+  location              = azurerm_resource_group.test.location
+  virtual_hub_id        = azurerm_virtual_hub.test.id
+  vendor                = "barracudasdwanrelease"
+  scale_unit            = 2
+  version               = "latest"
+  virtual_appliance_asn = 64512
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r VirtualHubNetworkVirtualApplianceResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_hub_network_virtual_appliance" "import" {
+  name                  = azurerm_virtual_hub_network_virtual_appliance.test.name
+  resource_group_name   = azurerm_virtual_hub_network_virtual_appliance.test.resource_group_name
+  location              = azurerm_virtual_hub_network_virtual_appliance.test.location
+  virtual_hub_id        = azurerm_virtual_hub_network_virtual_appliance.test.virtual_hub_id
+  vendor                = azurerm_virtual_hub_network_virtual_appliance.test.vendor
+  scale_unit            = azurerm_virtual_hub_network_virtual_appliance.test.scale_unit
+  version               = azurerm_virtual_hub_network_virtual_appliance.test.version
+  virtual_appliance_asn = azurerm_virtual_hub_network_virtual_appliance.test.virtual_appliance_asn
+}
+`, r.basic(data))
+}
+
+func (r VirtualHubNetworkVirtualApplianceResource) withTags(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_hub_network_virtual_appliance" "test" {
+  name                  = "acctest-nva-%d"
+  resource_group_name   = azurerm_resource_group.test.name
+  location              = azurerm_resource_group.test.location
+  virtual_hub_id        = azurerm_virtual_hub.test.id
+  vendor                = "barracudasdwanrelease"
+  scale_unit            = 2
+  version               = "latest"
+  virtual_appliance_asn = 64512
+
+  tags = {
+    environment = "Test"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r VirtualHubNetworkVirtualApplianceResource) invalidScaleUnit(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_hub_network_virtual_appliance" "test" {
+  name                  = "acctest-nva-%d"
+  resource_group_name   = azurerm_resource_group.test.name
+  location              = azurerm_resource_group.test.location
+  virtual_hub_id        = azurerm_virtual_hub.test.id
+  vendor                = "barracudasdwanrelease"
+  scale_unit            = 3
+  version               = "latest"
+  virtual_appliance_asn = 64512
+}
+`, r.template(data), data.RandomInteger)
+}
@@ -0,0 +1,275 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	firewallValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+const (
+	routingIntentDestinationInternet       = "Internet"
+	routingIntentDestinationPrivateTraffic = "PrivateTraffic"
+)
+
+func resourceVirtualHubRoutingIntent() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceVirtualHubRoutingIntentCreateUpdate,
+		Read:   resourceVirtualHubRoutingIntentRead,
+		Update: resourceVirtualHubRoutingIntentCreateUpdate,
+		Delete: resourceVirtualHubRoutingIntentDelete,
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceVirtualHubRoutingIntentCustomizeDiff),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.RoutingIntentID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"virtual_hub_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: networkValidate.VirtualHubID,
+			},
+
+			"routing_policy": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 2,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"destinations": {
+							Type:     pluginsdk.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									routingIntentDestinationInternet,
+									routingIntentDestinationPrivateTraffic,
+								}, false),
+							},
+						},
+
+						"next_hop": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: firewallValidate.FirewallID,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceVirtualHubRoutingIntentCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.RoutingIntentClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	virtHubId, err := parse.VirtualHubID(d.Get("virtual_hub_id").(string))
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(virtHubId.Name, virtualHubResourceName)
+	defer locks.UnlockByName(virtHubId.Name, virtualHubResourceName)
+
+	id := parse.NewRoutingIntentID(virtHubId.SubscriptionId, virtHubId.ResourceGroup, virtHubId.Name, d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.VirtualHubName, id.RoutingIntentName)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of %s: %+v", id, err)
+			}
+		}
+
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return tf.ImportAsExistsError("azurerm_virtual_hub_routing_intent", id.ID())
+		}
+	}
+
+	parameters := network.RoutingIntent{
+		Name: utils.String(id.RoutingIntentName),
+		RoutingIntentProperties: &network.RoutingIntentProperties{
+			RoutingPolicies: expandVirtualHubRoutingIntentRoutingPolicies(d.Get("routing_policy").([]interface{})),
+		},
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.VirtualHubName, id.RoutingIntentName, parameters)
+	if err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting on creating/updating future for %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceVirtualHubRoutingIntentRead(d, meta)
+}
+
+func resourceVirtualHubRoutingIntentRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.RoutingIntentClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.RoutingIntentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.VirtualHubName, id.RoutingIntentName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.RoutingIntentName)
+	d.Set("virtual_hub_id", parse.NewVirtualHubID(id.SubscriptionId, id.ResourceGroup, id.VirtualHubName).ID())
+
+	if props := resp.RoutingIntentProperties; props != nil {
+		if err := d.Set("routing_policy", flattenVirtualHubRoutingIntentRoutingPolicies(props.RoutingPolicies)); err != nil {
+			return fmt.Errorf("setting `routing_policy`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceVirtualHubRoutingIntentDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.RoutingIntentClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.RoutingIntentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.VirtualHubName, virtualHubResourceName)
+	defer locks.UnlockByName(id.VirtualHubName, virtualHubResourceName)
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.VirtualHubName, id.RoutingIntentName)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting on deleting future for %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func resourceVirtualHubRoutingIntentCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, meta interface{}) error {
+	if !diff.HasChange("routing_policy") {
+		return nil
+	}
+
+	usedDestinations := make(map[string]string)
+	for i, item := range diff.Get("routing_policy").([]interface{}) {
+		// the destinations may be interpolated from values which aren't known until apply
+		if !diff.NewValueKnown(fmt.Sprintf("routing_policy.%d.destinations", i)) {
+			continue
+		}
+
+		v := item.(map[string]interface{})
+		name := v["name"].(string)
+
+		for _, destination := range *utils.ExpandStringSlice(v["destinations"].([]interface{})) {
+			// each type of traffic can only be routed by a single routing policy
+			if existing, ok := usedDestinations[destination]; ok {
+				return fmt.Errorf("the destination %q is used by both the routing policy %q and %q - each destination can only be used by a single `routing_policy`", destination, existing, name)
+			}
+			usedDestinations[destination] = name
+		}
+	}
+
+	return nil
+}
+
+func expandVirtualHubRoutingIntentRoutingPolicies(input []interface{}) *[]network.RoutingPolicy {
+	results := make([]network.RoutingPolicy, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		results = append(results, network.RoutingPolicy{
+			Name:         utils.String(v["name"].(string)),
+			Destinations: utils.ExpandStringSlice(v["destinations"].([]interface{})),
+			NextHop:      utils.String(v["next_hop"].(string)),
+		})
+	}
+
+	return &results
+}
+
+func flattenVirtualHubRoutingIntentRoutingPolicies(input *[]network.RoutingPolicy) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		var name string
+		if item.Name != nil {
+			name = *item.Name
+		}
+
+		var nextHop string
+		if item.NextHop != nil {
+			nextHop = *item.NextHop
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":         name,
+			"destinations": utils.FlattenStringSlice(item.Destinations),
+			"next_hop":     nextHop,
+		})
+	}
+
+	return results
+}
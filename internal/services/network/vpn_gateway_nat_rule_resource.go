@@ -1,8 +1,11 @@
 package network
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"net"
+	"sort"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
@@ -102,6 +105,20 @@ func resourceVPNGatewayNatRule() *pluginsdk.Resource {
 				}, false),
 			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+			if d.Get("type").(string) != string(network.VpnNatRuleTypeStatic) {
+				return nil
+			}
+
+			if !d.NewValueKnown("internal_address_space_mappings") || !d.NewValueKnown("external_address_space_mappings") {
+				return nil
+			}
+
+			internal := d.Get("internal_address_space_mappings").(*pluginsdk.Set).List()
+			external := d.Get("external_address_space_mappings").(*pluginsdk.Set).List()
+			return validateVpnGatewayNatRuleStaticMappings(internal, external)
+		}),
 	}
 }
 
@@ -248,3 +265,45 @@ func flattenVpnGatewayNatRuleAddressSpaceMappings(input *[]network.VpnNatRuleMap
 
 	return results
 }
+
+// validateVpnGatewayNatRuleStaticMappings ensures a Static NAT Rule maps each internal address space to an
+// external address space of the same size, since a Static NAT Rule is a one-to-one mapping
+func validateVpnGatewayNatRuleStaticMappings(internal []interface{}, external []interface{}) error {
+	internalSizes, err := vpnGatewayNatRuleAddressSpaceSizes(internal)
+	if err != nil {
+		return fmt.Errorf("parsing `internal_address_space_mappings`: %+v", err)
+	}
+
+	externalSizes, err := vpnGatewayNatRuleAddressSpaceSizes(external)
+	if err != nil {
+		return fmt.Errorf("parsing `external_address_space_mappings`: %+v", err)
+	}
+
+	if len(internalSizes) != len(externalSizes) {
+		return fmt.Errorf("a `Static` NAT Rule must have the same number of `internal_address_space_mappings` (%d) and `external_address_space_mappings` (%d)", len(internalSizes), len(externalSizes))
+	}
+
+	for i := range internalSizes {
+		if internalSizes[i] != externalSizes[i] {
+			return fmt.Errorf("a `Static` NAT Rule must map each of the `internal_address_space_mappings` to an `external_address_space_mappings` with the same prefix length")
+		}
+	}
+
+	return nil
+}
+
+func vpnGatewayNatRuleAddressSpaceSizes(input []interface{}) ([]int, error) {
+	results := make([]int, 0)
+	for _, v := range input {
+		_, ipNet, err := net.ParseCIDR(v.(string))
+		if err != nil {
+			return nil, err
+		}
+
+		ones, _ := ipNet.Mask.Size()
+		results = append(results, ones)
+	}
+
+	sort.Ints(results)
+	return results, nil
+}
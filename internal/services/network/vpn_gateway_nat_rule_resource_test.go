@@ -3,6 +3,7 @@ package network_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
@@ -82,6 +83,18 @@ func TestAccVpnGatewayNatRule_update(t *testing.T) {
 	})
 }
 
+func TestAccVpnGatewayNatRule_staticMappingsDifferentSize(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_vpn_gateway_nat_rule", "test")
+	r := VPNGatewayNatRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.staticMappingsDifferentSize(data),
+			ExpectError: regexp.MustCompile("with the same prefix length"),
+		},
+	})
+}
+
 func (r VPNGatewayNatRuleResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.VpnGatewayNatRuleID(state.ID)
 	if err != nil {
@@ -144,6 +157,21 @@ resource "azurerm_vpn_gateway_nat_rule" "test" {
 `, r.template(data), data.RandomInteger)
 }
 
+func (r VPNGatewayNatRuleResource) staticMappingsDifferentSize(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vpn_gateway_nat_rule" "test" {
+  name                            = "acctest-vpnnatrule-%d"
+  resource_group_name             = azurerm_resource_group.test.name
+  vpn_gateway_id                  = azurerm_vpn_gateway.test.id
+  external_address_space_mappings = ["192.168.21.0/24"]
+  internal_address_space_mappings = ["10.4.0.0/26"]
+  type                            = "Static"
+}
+`, r.template(data), data.RandomInteger)
+}
+
 func (r VPNGatewayNatRuleResource) requiresImport(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -1,6 +1,7 @@
 package network
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -35,6 +36,8 @@ func resourceVPNServerConfiguration() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(90 * time.Minute),
 		},
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceVPNServerConfigurationCustomizeDiff),
+
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:         pluginsdk.TypeString,
@@ -382,6 +385,37 @@ func resourceVPNServerConfiguration() *pluginsdk.Resource {
 	}
 }
 
+func resourceVPNServerConfigurationCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+	if !d.NewValueKnown("vpn_authentication_types") {
+		return nil
+	}
+
+	supportsAAD := false
+	seen := make(map[string]struct{})
+	for _, v := range d.Get("vpn_authentication_types").([]interface{}) {
+		authType := v.(string)
+		if _, exists := seen[authType]; exists {
+			return fmt.Errorf("`vpn_authentication_types` contains %q more than once", authType)
+		}
+		seen[authType] = struct{}{}
+
+		if authType == string(network.VpnAuthenticationTypeAAD) {
+			supportsAAD = true
+		}
+	}
+
+	// Azure Active Directory authentication is only supported for connections using the OpenVPN protocol
+	if supportsAAD && d.NewValueKnown("vpn_protocols") {
+		for _, v := range d.Get("vpn_protocols").(*pluginsdk.Set).List() {
+			if v.(string) != string(network.VpnGatewayTunnelingProtocolOpenVPN) {
+				return fmt.Errorf("`vpn_protocols` must only contain `OpenVPN` when `vpn_authentication_types` contains `AAD`, got %q", v.(string))
+			}
+		}
+	}
+
+	return nil
+}
+
 func resourceVPNServerConfigurationCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Network.VpnServerConfigurationsClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
@@ -3,6 +3,7 @@ package network_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
@@ -188,6 +189,18 @@ func TestAccVPNServerConfiguration_multipleAuthTypes(t *testing.T) {
 	})
 }
 
+func TestAccVPNServerConfiguration_azureADWithIkeV2(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_vpn_server_configuration", "test")
+	r := VPNServerConfigurationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.azureADWithIkeV2(data),
+			ExpectError: regexp.MustCompile("`vpn_protocols` must only contain `OpenVPN` when `vpn_authentication_types` contains `AAD`"),
+		},
+	})
+}
+
 func (t VPNServerConfigurationResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.VpnServerConfigurationID(state.ID)
 	if err != nil {
@@ -202,6 +215,28 @@ func (t VPNServerConfigurationResource) Exists(ctx context.Context, clients *cli
 	return utils.Bool(resp.ID != nil), nil
 }
 
+func (r VPNServerConfigurationResource) azureADWithIkeV2(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_subscription" "current" {}
+
+resource "azurerm_vpn_server_configuration" "test" {
+  name                     = "acctestVPNSC-%d"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  vpn_authentication_types = ["AAD"]
+  vpn_protocols            = ["IkeV2", "OpenVPN"]
+
+  azure_active_directory_authentication {
+    audience = "00000000-abcd-abcd-abcd-999999999999"
+    issuer   = "https://sts.windows.net/${data.azurerm_subscription.current.tenant_id}/"
+    tenant   = "https://login.microsoftonline.com/${data.azurerm_subscription.current.tenant_id}"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
 func (r VPNServerConfigurationResource) azureAD(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -28,6 +28,7 @@ const (
 	apnsProductionEndpoint = "https://api.push.apple.com:443/3/device"
 	apnsSandboxName        = "Sandbox"
 	apnsSandboxEndpoint    = "https://api.development.push.apple.com:443/3/device"
+	baiduDefaultEndpoint   = "https://channel.api.duapp.com/rest/2.0/channel/channel"
 )
 
 func resourceNotificationHub() *pluginsdk.Resource {
@@ -72,6 +73,13 @@ func resourceNotificationHub() *pluginsdk.Resource {
 				diff.ForceNew("gcm_credential")
 			}
 
+			oBaidu, nBaidu := diff.GetChange("baidu_credential.#")
+			oBaidui := oBaidu.(int)
+			nBaidui := nBaidu.(int)
+			if nBaidui < oBaidui {
+				diff.ForceNew("baidu_credential")
+			}
+
 			return nil
 		}),
 
@@ -146,6 +154,34 @@ func resourceNotificationHub() *pluginsdk.Resource {
 				},
 			},
 
+			"baidu_credential": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"api_key": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"secret_key": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"endpoint": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Default:      baiduDefaultEndpoint,
+							ValidateFunc: validation.IsURLWithHTTPS,
+						},
+					},
+				},
+			},
+
 			"tags": tags.Schema(),
 		},
 	}
@@ -174,8 +210,9 @@ func resourceNotificationHubCreateUpdate(d *pluginsdk.ResourceData, meta interfa
 	parameters := notificationhubs.CreateOrUpdateParameters{
 		Location: utils.String(location.Normalize(d.Get("location").(string))),
 		Properties: &notificationhubs.Properties{
-			ApnsCredential: expandNotificationHubsAPNSCredentials(d.Get("apns_credential").([]interface{})),
-			GcmCredential:  expandNotificationHubsGCMCredentials(d.Get("gcm_credential").([]interface{})),
+			ApnsCredential:  expandNotificationHubsAPNSCredentials(d.Get("apns_credential").([]interface{})),
+			GcmCredential:   expandNotificationHubsGCMCredentials(d.Get("gcm_credential").([]interface{})),
+			BaiduCredential: expandNotificationHubsBaiduCredentials(d.Get("baidu_credential").([]interface{})),
 		},
 		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
@@ -262,6 +299,11 @@ func resourceNotificationHubRead(d *pluginsdk.ResourceData, meta interface{}) er
 		if setErr := d.Set("gcm_credential", gcm); setErr != nil {
 			return fmt.Errorf("setting `gcm_credential`: %+v", setErr)
 		}
+
+		baidu := flattenNotificationHubsBaiduCredentials(props.BaiduCredential)
+		if setErr := d.Set("baidu_credential", baidu); setErr != nil {
+			return fmt.Errorf("setting `baidu_credential`: %+v", setErr)
+		}
 	}
 
 	return tags.FlattenAndSet(d, resp.Tags)
@@ -381,3 +423,42 @@ func flattenNotificationHubsGCMCredentials(input *notificationhubs.GcmCredential
 
 	return []interface{}{output}
 }
+
+func expandNotificationHubsBaiduCredentials(inputs []interface{}) *notificationhubs.BaiduCredential {
+	if len(inputs) == 0 {
+		return nil
+	}
+
+	input := inputs[0].(map[string]interface{})
+	credentials := notificationhubs.BaiduCredential{
+		BaiduCredentialProperties: &notificationhubs.BaiduCredentialProperties{
+			BaiduAPIKey:    utils.String(input["api_key"].(string)),
+			BaiduEndPoint:  utils.String(input["endpoint"].(string)),
+			BaiduSecretKey: utils.String(input["secret_key"].(string)),
+		},
+	}
+	return &credentials
+}
+
+func flattenNotificationHubsBaiduCredentials(input *notificationhubs.BaiduCredential) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	output := make(map[string]interface{})
+	if props := input.BaiduCredentialProperties; props != nil {
+		if apiKey := props.BaiduAPIKey; apiKey != nil {
+			output["api_key"] = *apiKey
+		}
+
+		if endpoint := props.BaiduEndPoint; endpoint != nil {
+			output["endpoint"] = *endpoint
+		}
+
+		if secretKey := props.BaiduSecretKey; secretKey != nil {
+			output["secret_key"] = *secretKey
+		}
+	}
+
+	return []interface{}{output}
+}
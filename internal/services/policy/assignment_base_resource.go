@@ -88,6 +88,10 @@ func (br assignmentBaseResource) createFunc(resourceName, scopeFieldName string)
 			}
 
 			if msgs := metadata.ResourceData.Get("non_compliance_message").([]interface{}); len(msgs) > 0 {
+				// this is checked here rather than during the plan, since the Policy Set Definition can be updated in the same apply
+				if err := br.validateNonComplianceMessagePolicyReferences(ctx, metadata.Client.Policy.SetDefinitionsClient, *assignment.PolicyDefinitionID, msgs); err != nil {
+					return err
+				}
 				assignment.NonComplianceMessages = br.expandNonComplianceMessages(msgs)
 			}
 
@@ -259,8 +263,13 @@ func (br assignmentBaseResource) updateFunc() sdk.ResourceFunc {
 				update.AssignmentProperties.NotScopes = expandAzureRmPolicyNotScopes(metadata.ResourceData.Get("not_scopes").([]interface{}))
 			}
 
-			if metadata.ResourceData.HasChange("non_compliance_message") {
-				update.AssignmentProperties.NonComplianceMessages = br.expandNonComplianceMessages(metadata.ResourceData.Get("non_compliance_message").([]interface{}))
+			if metadata.ResourceData.HasChanges("non_compliance_message", "policy_definition_id") {
+				// this is checked here rather than during the plan, since the Policy Set Definition can be updated in the same apply
+				msgs := metadata.ResourceData.Get("non_compliance_message").([]interface{})
+				if err := br.validateNonComplianceMessagePolicyReferences(ctx, metadata.Client.Policy.SetDefinitionsClient, metadata.ResourceData.Get("policy_definition_id").(string), msgs); err != nil {
+					return err
+				}
+				update.AssignmentProperties.NonComplianceMessages = br.expandNonComplianceMessages(msgs)
 			}
 
 			if metadata.ResourceData.HasChange("parameters") {
@@ -450,3 +459,50 @@ func (br assignmentBaseResource) expandNonComplianceMessages(input []interface{}
 
 	return &output
 }
+
+// validateNonComplianceMessagePolicyReferences checks that any `policy_definition_reference_id` used within a
+// `non_compliance_message` refers to a Policy Definition within the assigned Policy Set Definition
+func (br assignmentBaseResource) validateNonComplianceMessagePolicyReferences(ctx context.Context, client *policy.SetDefinitionsClient, policyDefinitionId string, input []interface{}) error {
+	referenceIds := make([]string, 0)
+	for _, v := range input {
+		if m, ok := v.(map[string]interface{}); ok {
+			if id := m["policy_definition_reference_id"].(string); id != "" {
+				referenceIds = append(referenceIds, id)
+			}
+		}
+	}
+	if len(referenceIds) == 0 {
+		return nil
+	}
+
+	setDefinitionId, err := parse.PolicySetDefinitionID(policyDefinitionId)
+	if err != nil {
+		return fmt.Errorf("`non_compliance_message.policy_definition_reference_id` can only be specified when `policy_definition_id` is a Policy Set Definition")
+	}
+
+	setDefinition, err := getPolicySetDefinitionByID(ctx, client, *setDefinitionId)
+	if err != nil {
+		if utils.ResponseWasNotFound(setDefinition.Response) {
+			// leave this to the API
+			return nil
+		}
+		return fmt.Errorf("retrieving Policy Set Definition %q: %+v", policyDefinitionId, err)
+	}
+
+	existing := make(map[string]bool)
+	if props := setDefinition.SetDefinitionProperties; props != nil && props.PolicyDefinitions != nil {
+		for _, def := range *props.PolicyDefinitions {
+			if def.PolicyDefinitionReferenceID != nil {
+				existing[*def.PolicyDefinitionReferenceID] = true
+			}
+		}
+	}
+
+	for _, id := range referenceIds {
+		if !existing[id] {
+			return fmt.Errorf("the `non_compliance_message.policy_definition_reference_id` %q was not found in the Policy Set Definition %q", id, policyDefinitionId)
+		}
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package policy_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
@@ -107,6 +108,18 @@ func TestAccResourceGroupPolicyAssignment_basicWithBuiltInPolicySet(t *testing.T
 	})
 }
 
+func TestAccResourceGroupPolicyAssignment_nonComplianceMessageInvalidReference(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_group_policy_assignment", "test")
+	r := ResourceGroupAssignmentTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.withBuiltInPolicySetNonComplianceMessageInvalidReference(data),
+			ExpectError: regexp.MustCompile("was not found in the Policy Set Definition"),
+		},
+	})
+}
+
 func TestAccResourceGroupPolicyAssignment_basicWithBuiltInPolicySetNonComplianceMessage(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_resource_group_policy_assignment", "test")
 	r := ResourceGroupAssignmentTestResource{}
@@ -431,6 +444,37 @@ resource "azurerm_resource_group_policy_assignment" "test" {
 `, template, data.RandomInteger)
 }
 
+func (r ResourceGroupAssignmentTestResource) withBuiltInPolicySetNonComplianceMessageInvalidReference(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+data "azurerm_policy_set_definition" "test" {
+  display_name = "Audit machines with insecure password security settings"
+}
+
+resource "azurerm_resource_group_policy_assignment" "test" {
+  name                 = "acctestpa-%[2]d"
+  resource_group_id    = azurerm_resource_group.test.id
+  policy_definition_id = data.azurerm_policy_set_definition.test.id
+  location             = azurerm_resource_group.test.location
+
+  non_compliance_message {
+    content                        = "test"
+    policy_definition_reference_id = "DoesNotExist"
+  }
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+`, template, data.RandomInteger)
+}
+
 func (r ResourceGroupAssignmentTestResource) withBuiltInPolicySetNonComplianceMessageUpdated(data acceptance.TestData) string {
 	template := r.template(data)
 	return fmt.Sprintf(`
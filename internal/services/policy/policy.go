@@ -7,6 +7,7 @@ import (
 	"fmt"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/resources/mgmt/2021-06-01-preview/policy"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
@@ -74,6 +75,24 @@ func getPolicySetDefinitionByName(ctx context.Context, client *policy.SetDefinit
 	return res, err
 }
 
+// getPolicySetDefinitionByID retrieves the Policy Set Definition at the scope specified within the ID - which can be
+// a Built-In Policy Set Definition, or one defined within a Management Group or (another) Subscription
+func getPolicySetDefinitionByID(ctx context.Context, client *policy.SetDefinitionsClient, id parse.PolicySetDefinitionId) (res policy.SetDefinition, err error) {
+	switch scope := id.PolicyScopeId.(type) {
+	case nil:
+		return client.GetBuiltIn(ctx, id.Name)
+	case parse.ScopeAtManagementGroup:
+		return client.GetAtManagementGroup(ctx, id.Name, scope.ManagementGroupName)
+	case parse.ScopeAtSubscription:
+		// the Policy Set Definition can be defined in a different Subscription to the one the Provider is configured for
+		subscriptionClient := *client
+		subscriptionClient.SubscriptionID = scope.SubscriptionId
+		return subscriptionClient.Get(ctx, id.Name)
+	default:
+		return res, fmt.Errorf("unsupported scope %q for Policy Set Definition %q", id.PolicyScopeId.ScopeId(), id.Name)
+	}
+}
+
 func getPolicySetDefinitionByDisplayName(ctx context.Context, client *policy.SetDefinitionsClient, displayName, managementGroupID string) (policy.SetDefinition, error) {
 	var setDefinitions policy.SetDefinitionListResultIterator
 	var err error
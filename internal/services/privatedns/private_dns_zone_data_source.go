@@ -7,6 +7,7 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/privatedns/mgmt/2018-09-01/privatedns"
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-06-01/resources"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
@@ -29,11 +30,7 @@ func dataSourcePrivateDnsZone() *pluginsdk.Resource {
 				Required: true,
 			},
 
-			"resource_group_name": {
-				Type:     pluginsdk.TypeString,
-				Optional: true,
-				Computed: true,
-			},
+			"resource_group_name": azure.SchemaResourceGroupNameOptional(),
 
 			"number_of_record_sets": {
 				Type:     pluginsdk.TypeInt,
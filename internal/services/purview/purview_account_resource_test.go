@@ -41,6 +41,9 @@ func TestAccPurviewAccount_complete(t *testing.T) {
 			Config: r.complete(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("managed_resources.0.resource_group_id").Exists(),
+				check.That(data.ResourceName).Key("managed_resources.0.storage_account_id").Exists(),
+				check.That(data.ResourceName).Key("managed_resources.0.event_hub_namespace_id").Exists(),
 			),
 		},
 		data.ImportStep(),
@@ -18,6 +18,7 @@ type Client struct {
 	ResourceProvidersClient     *resources.ProvidersClient
 	ResourcesClient             *resources.Client
 	TagsClient                  *resources.TagsClient
+	TemplateSpecsClient         *templatespecs.Client
 	TemplateSpecsVersionsClient *templatespecs.VersionsClient
 
 	options *common.ClientOptions
@@ -47,6 +48,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	resourcesClient := resources.NewClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&resourcesClient.Client, o.ResourceManagerAuthorizer)
 
+	templatespecsClient := templatespecs.NewClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&templatespecsClient.Client, o.ResourceManagerAuthorizer)
+
 	templatespecsVersionsClient := templatespecs.NewVersionsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&templatespecsVersionsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -62,6 +66,7 @@ func NewClient(o *common.ClientOptions) *Client {
 		ResourceProvidersClient:     &resourceProvidersClient,
 		ResourcesClient:             &resourcesClient,
 		TagsClient:                  &tagsClient,
+		TemplateSpecsClient:         &templatespecsClient,
 		TemplateSpecsVersionsClient: &templatespecsVersionsClient,
 
 		options: o,
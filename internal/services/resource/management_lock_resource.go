@@ -43,9 +43,10 @@ func resourceManagementLock() *pluginsdk.Resource {
 			},
 
 			"scope": {
-				Type:     pluginsdk.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ManagementLockScope,
 			},
 
 			"lock_level": {
@@ -95,7 +96,11 @@ func resourceManagementLockCreateUpdate(d *pluginsdk.ResourceData, meta interfac
 		},
 	}
 
-	if _, err := client.CreateOrUpdateByScope(ctx, id.Scope, id.Name, lock); err != nil {
+	if resp, err := client.CreateOrUpdateByScope(ctx, id.Scope, id.Name, lock); err != nil {
+		if utils.ResponseWasConflict(resp.Response) {
+			return fmt.Errorf("creating %s: the scope %q (or one of its parents) is locked by another `ReadOnly` Management Lock which must be removed first: %+v", id, id.Scope, err)
+		}
+
 		return fmt.Errorf("creating %s: %+v", id, err)
 	}
 
@@ -151,6 +156,10 @@ func resourceManagementLockDelete(d *pluginsdk.ResourceData, meta interface{}) e
 			return nil
 		}
 
+		if utils.ResponseWasConflict(resp) {
+			return fmt.Errorf("deleting %s: the scope %q (or one of its parents) is locked by another `ReadOnly` Management Lock which must be removed first: %+v", *id, id.Scope, err)
+		}
+
 		return fmt.Errorf("deleting %s: %+v", *id, err)
 	}
 
@@ -161,6 +161,27 @@ func TestAccManagementLock_subscriptionCanNotDeleteBasic(t *testing.T) {
 	})
 }
 
+func TestAccManagementLock_subscriptionCanNotDeleteComplete(t *testing.T) {
+	_, exists := os.LookupEnv("TF_ACC_SUBSCRIPTION_PARALLEL_LOCK")
+	if !exists {
+		t.Skip("`TF_ACC_SUBSCRIPTION_PARALLEL_LOCK` isn't specified - skipping since this test can't be run in Parallel")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_management_lock", "test")
+	r := ManagementLockResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.subscriptionCanNotDeleteComplete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("notes").HasValue("Hello, World!"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (t ManagementLockResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.ParseManagementLockID(state.ID)
 	if err != nil {
@@ -352,3 +373,21 @@ resource "azurerm_management_lock" "test" {
 }
 `, data.RandomInteger)
 }
+
+func (ManagementLockResource) subscriptionCanNotDeleteComplete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_subscription" "current" {
+}
+
+resource "azurerm_management_lock" "test" {
+  name       = "acctestlock-%d"
+  scope      = data.azurerm_subscription.current.id
+  lock_level = "CanNotDelete"
+  notes      = "Hello, World!"
+}
+`, data.RandomInteger)
+}
@@ -29,9 +29,10 @@ func (r Registration) WebsiteCategories() []string {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_resources":             dataSourceResources(),
-		"azurerm_resource_group":        dataSourceResourceGroup(),
-		"azurerm_template_spec_version": dataSourceTemplateSpecVersion(),
+		"azurerm_resources":                                  dataSourceResources(),
+		"azurerm_resource_group":                             dataSourceResourceGroup(),
+		"azurerm_resource_group_template_deployment_what_if": dataSourceResourceGroupTemplateDeploymentWhatIf(),
+		"azurerm_template_spec_version":                      dataSourceTemplateSpecVersion(),
 	}
 }
 
@@ -44,6 +45,8 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_resource_group_template_deployment":   resourceGroupTemplateDeploymentResource(),
 		"azurerm_subscription_template_deployment":     subscriptionTemplateDeploymentResource(),
 		"azurerm_template_deployment":                  resourceTemplateDeployment(),
+		"azurerm_template_spec":                        resourceTemplateSpec(),
+		"azurerm_template_spec_version":                resourceTemplateSpecVersion(),
 		"azurerm_tenant_template_deployment":           tenantTemplateDeploymentResource(),
 	}
 }
@@ -0,0 +1,158 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-06-01/resources"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func dataSourceResourceGroupTemplateDeploymentWhatIf() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceResourceGroupTemplateDeploymentWhatIfRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		//lintignore:S033
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.TemplateDeploymentName,
+			},
+
+			"resource_group_name": commonschema.ResourceGroupNameForDataSource(),
+
+			"deployment_mode": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(resources.DeploymentModeComplete),
+					string(resources.DeploymentModeIncremental),
+				}, false),
+			},
+
+			"template_content": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ExactlyOneOf: []string{
+					"template_content",
+					"template_spec_version_id",
+				},
+				ValidateFunc: validation.StringIsJSON,
+			},
+
+			"template_spec_version_id": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ExactlyOneOf: []string{
+					"template_content",
+					"template_spec_version_id",
+				},
+				ValidateFunc: validate.TemplateSpecVersionID,
+			},
+
+			"parameters_content": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+
+			"changes": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceResourceGroupTemplateDeploymentWhatIfRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Resource.DeploymentsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewResourceGroupTemplateDeploymentID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+
+	properties := resources.DeploymentWhatIfProperties{
+		Mode: resources.DeploymentMode(d.Get("deployment_mode").(string)),
+		WhatIfSettings: &resources.DeploymentWhatIfSettings{
+			ResultFormat: resources.WhatIfResultFormatResourceIDOnly,
+		},
+	}
+
+	if templateSpecVersionId := d.Get("template_spec_version_id").(string); templateSpecVersionId != "" {
+		properties.TemplateLink = &resources.TemplateLink{
+			ID: utils.String(templateSpecVersionId),
+		}
+	} else {
+		template, err := expandTemplateDeploymentBody(d.Get("template_content").(string))
+		if err != nil {
+			return fmt.Errorf("expanding `template_content`: %+v", err)
+		}
+		properties.Template = template
+	}
+
+	if v := d.Get("parameters_content").(string); v != "" {
+		parameters, err := expandTemplateDeploymentBody(v)
+		if err != nil {
+			return fmt.Errorf("expanding `parameters_content`: %+v", err)
+		}
+		properties.Parameters = parameters
+	}
+
+	changes, err := whatIfResourceGroupTemplateDeployment(ctx, id, properties, client)
+	if err != nil {
+		return fmt.Errorf("determining the What-If changes for Template Deployment %q (Resource Group %q): %+v", id.DeploymentName, id.ResourceGroup, err)
+	}
+
+	d.SetId(id.ID())
+
+	if err := d.Set("changes", changes); err != nil {
+		return fmt.Errorf("setting `changes`: %+v", err)
+	}
+
+	return nil
+}
+
+func whatIfResourceGroupTemplateDeployment(ctx context.Context, id parse.ResourceGroupTemplateDeploymentId, properties resources.DeploymentWhatIfProperties, client *resources.DeploymentsClient) ([]interface{}, error) {
+	future, err := client.WhatIf(ctx, id.ResourceGroup, id.DeploymentName, resources.DeploymentWhatIf{
+		Properties: &properties,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("requesting What-If: %+v", err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return nil, fmt.Errorf("waiting for What-If: %+v", err)
+	}
+
+	result, err := future.Result(*client)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving What-If result: %+v", err)
+	}
+
+	if result.Error != nil {
+		message := ""
+		if result.Error.Message != nil {
+			message = *result.Error.Message
+		}
+		return nil, fmt.Errorf("What-If failed: %s", message)
+	}
+
+	return flattenTemplateDeploymentWhatIfChanges(result.WhatIfOperationProperties), nil
+}
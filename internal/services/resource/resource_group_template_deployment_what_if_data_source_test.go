@@ -0,0 +1,62 @@
+package resource_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type ResourceGroupTemplateDeploymentWhatIfDataSource struct{}
+
+func TestAccDataSourceResourceGroupTemplateDeploymentWhatIf_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_resource_group_template_deployment_what_if", "test")
+	r := ResourceGroupTemplateDeploymentWhatIfDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("changes.#").HasValue("1"),
+				check.That(data.ResourceName).Key("changes.0").MatchesRegex(regexp.MustCompile(`^Modify: /subscriptions/.+/providers/Microsoft.Network/publicIPAddresses/acctestpip-\d+$`)),
+			),
+		},
+	})
+}
+
+func (ResourceGroupTemplateDeploymentWhatIfDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_resource_group_template_deployment_what_if" "test" {
+  name                = azurerm_resource_group_template_deployment.test.name
+  resource_group_name = azurerm_resource_group_template_deployment.test.resource_group_name
+  deployment_mode     = "Complete"
+
+  template_content = <<TEMPLATE
+{
+  "$schema": "https://schema.management.azure.com/schemas/2015-01-01/deploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "parameters": {},
+  "variables": {},
+  "resources": [
+    {
+      "type": "Microsoft.Network/publicIPAddresses",
+      "apiVersion": "2015-06-15",
+      "name": "acctestpip-%d",
+      "location": "[resourceGroup().location]",
+      "properties": {
+        "publicIPAllocationMethod": "Dynamic"
+      },
+      "tags": {
+        "Hello": "second"
+      }
+    }
+  ]
+}
+TEMPLATE
+}
+`, ResourceGroupTemplateDeploymentResource{}.singleItemWithPublicIPConfig(data, "first"), data.RandomInteger)
+}
@@ -48,10 +48,13 @@ const (
 func (r ResourceProviderRegistrationResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
-			Type:         pluginsdk.TypeString,
-			Required:     true,
-			ForceNew:     true,
-			ValidateFunc: resourceproviders.EnhancedValidate,
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.All(
+				validate.ResourceProviderNamespace,
+				resourceproviders.EnhancedValidate,
+			),
 		},
 
 		"feature": {
@@ -28,11 +28,7 @@ func dataSourceResources() *pluginsdk.Resource {
 				Optional: true,
 				Computed: true,
 			},
-			"resource_group_name": {
-				Type:     pluginsdk.TypeString,
-				Optional: true,
-				Computed: true,
-			},
+			"resource_group_name": azure.SchemaResourceGroupNameOptional(),
 			"type": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
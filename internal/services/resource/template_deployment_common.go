@@ -253,3 +253,27 @@ func findApiVersionForResourceType(resourceType string, availableResourceTypes [
 
 	return nil
 }
+
+// flattenTemplateDeploymentWhatIfChanges returns the predicted changes in the format `{ChangeType}: {ResourceId}`,
+// omitting resources which won't be changed
+func flattenTemplateDeploymentWhatIfChanges(input *resources.WhatIfOperationProperties) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil || input.Changes == nil {
+		return output
+	}
+
+	for _, change := range *input.Changes {
+		if change.ChangeType == resources.ChangeTypeNoChange || change.ChangeType == resources.ChangeTypeIgnore {
+			continue
+		}
+
+		resourceId := ""
+		if change.ResourceID != nil {
+			resourceId = *change.ResourceID
+		}
+
+		output = append(output, fmt.Sprintf("%s: %s", string(change.ChangeType), resourceId))
+	}
+
+	return output
+}
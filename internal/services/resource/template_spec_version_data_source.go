@@ -43,6 +43,29 @@ func dataSourceTemplateSpecVersion() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"description": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"linked_templates": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"path": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"template_body": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
 			"tags": tags.SchemaDataSource(),
 		},
 	}
@@ -65,15 +88,32 @@ func dataSourceTemplateSpecVersionRead(d *pluginsdk.ResourceData, meta interface
 	}
 
 	templateBody := "{}"
-	if props := resp.VersionProperties; props != nil && props.Template != nil {
-		templateBodyRaw, err := flattenTemplateDeploymentBody(props.Template)
-		if err != nil {
-			return err
+	description := ""
+	linkedTemplates := make([]interface{}, 0)
+	if props := resp.VersionProperties; props != nil {
+		if props.Template != nil {
+			templateBodyRaw, err := flattenTemplateDeploymentBody(props.Template)
+			if err != nil {
+				return err
+			}
+
+			templateBody = *templateBodyRaw
+		}
+
+		if props.Description != nil {
+			description = *props.Description
 		}
 
-		templateBody = *templateBodyRaw
+		linkedTemplates, err = flattenTemplateSpecVersionLinkedTemplates(props.Artifacts)
+		if err != nil {
+			return fmt.Errorf("flattening `linked_templates`: %+v", err)
+		}
 	}
 	d.Set("template_body", templateBody)
+	d.Set("description", description)
+	if err := d.Set("linked_templates", linkedTemplates); err != nil {
+		return fmt.Errorf("setting `linked_templates`: %+v", err)
+	}
 
 	d.SetId(id.ID())
 
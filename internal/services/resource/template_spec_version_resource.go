@@ -0,0 +1,267 @@
+package resource
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/resources/mgmt/2019-06-01-preview/templatespecs"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceTemplateSpecVersion() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceTemplateSpecVersionCreateUpdate,
+		Read:   resourceTemplateSpecVersionRead,
+		Update: resourceTemplateSpecVersionCreateUpdate,
+		Delete: resourceTemplateSpecVersionDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.TemplateSpecVersionID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.TemplateSpecVersionName,
+			},
+
+			"template_spec_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.TemplateSpecID,
+			},
+
+			"template_body": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsJSON,
+				StateFunc:    utils.NormalizeJson,
+			},
+
+			"description": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 4096),
+			},
+
+			"linked_templates": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"path": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"template_body": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsJSON,
+							StateFunc:    utils.NormalizeJson,
+						},
+					},
+				},
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceTemplateSpecVersionCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Resource.TemplateSpecsVersionsClient
+	templateSpecsClient := meta.(*clients.Client).Resource.TemplateSpecsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	templateSpecId, err := parse.TemplateSpecID(d.Get("template_spec_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewTemplateSpecVersionID(templateSpecId.SubscriptionId, templateSpecId.ResourceGroup, templateSpecId.Name, d.Get("name").(string))
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.TemplateSpecName, id.VersionName)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return tf.ImportAsExistsError("azurerm_template_spec_version", id.ID())
+		}
+	}
+
+	// a Template Spec Version has to be created in the same location as the Template Spec
+	templateSpec, err := templateSpecsClient.Get(ctx, templateSpecId.ResourceGroup, templateSpecId.Name, "")
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *templateSpecId, err)
+	}
+
+	template, err := expandTemplateDeploymentBody(d.Get("template_body").(string))
+	if err != nil {
+		return fmt.Errorf("expanding `template_body`: %+v", err)
+	}
+
+	artifacts, err := expandTemplateSpecVersionLinkedTemplates(d.Get("linked_templates").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("expanding `linked_templates`: %+v", err)
+	}
+
+	version := templatespecs.VersionTemplatespecs{
+		Location: templateSpec.Location,
+		VersionProperties: &templatespecs.VersionProperties{
+			Artifacts: artifacts,
+			Template:  template,
+		},
+		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		version.VersionProperties.Description = utils.String(v.(string))
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.TemplateSpecName, id.VersionName, version); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceTemplateSpecVersionRead(d, meta)
+}
+
+func resourceTemplateSpecVersionRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Resource.TemplateSpecsVersionsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.TemplateSpecVersionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.TemplateSpecName, id.VersionName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.VersionName)
+	d.Set("template_spec_id", parse.NewTemplateSpecID(id.SubscriptionId, id.ResourceGroup, id.TemplateSpecName).ID())
+
+	if props := resp.VersionProperties; props != nil {
+		d.Set("description", props.Description)
+
+		templateBody, err := flattenTemplateDeploymentBody(props.Template)
+		if err != nil {
+			return fmt.Errorf("flattening `template_body`: %+v", err)
+		}
+		d.Set("template_body", templateBody)
+
+		linkedTemplates, err := flattenTemplateSpecVersionLinkedTemplates(props.Artifacts)
+		if err != nil {
+			return fmt.Errorf("flattening `linked_templates`: %+v", err)
+		}
+		if err := d.Set("linked_templates", linkedTemplates); err != nil {
+			return fmt.Errorf("setting `linked_templates`: %+v", err)
+		}
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceTemplateSpecVersionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Resource.TemplateSpecsVersionsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.TemplateSpecVersionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.Delete(ctx, id.ResourceGroup, id.TemplateSpecName, id.VersionName); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandTemplateSpecVersionLinkedTemplates(input []interface{}) (*[]templatespecs.BasicArtifact, error) {
+	output := make([]templatespecs.BasicArtifact, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		template, err := expandTemplateDeploymentBody(v["template_body"].(string))
+		if err != nil {
+			return nil, fmt.Errorf("expanding `template_body` for linked template %q: %+v", v["path"].(string), err)
+		}
+
+		output = append(output, templatespecs.TemplateArtifact{
+			Kind:     templatespecs.KindTemplate,
+			Path:     utils.String(v["path"].(string)),
+			Template: template,
+		})
+	}
+
+	return &output, nil
+}
+
+func flattenTemplateSpecVersionLinkedTemplates(input *[]templatespecs.BasicArtifact) ([]interface{}, error) {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output, nil
+	}
+
+	for _, item := range *input {
+		artifact, ok := item.AsTemplateArtifact()
+		if !ok {
+			continue
+		}
+
+		path := ""
+		if artifact.Path != nil {
+			path = *artifact.Path
+		}
+
+		templateBody, err := flattenTemplateDeploymentBody(artifact.Template)
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, map[string]interface{}{
+			"path":          path,
+			"template_body": *templateBody,
+		})
+	}
+
+	return output, nil
+}
@@ -0,0 +1,219 @@
+package resource_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type TemplateSpecVersionResource struct{}
+
+func TestAccTemplateSpecVersion_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_template_spec_version", "test")
+	r := TemplateSpecVersionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccTemplateSpecVersion_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_template_spec_version", "test")
+	r := TemplateSpecVersionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccTemplateSpecVersion_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_template_spec_version", "test")
+	r := TemplateSpecVersionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("linked_templates.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccTemplateSpecVersion_multipleVersions(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_template_spec_version", "test")
+	r := TemplateSpecVersionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.multipleVersions(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azurerm_template_spec_version.second").ExistsInAzure(r),
+				check.That("data.azurerm_template_spec_version.test").Key("description").HasValue("Version 2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (TemplateSpecVersionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.TemplateSpecVersionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Resource.TemplateSpecsVersionsClient.Get(ctx, id.ResourceGroup, id.TemplateSpecName, id.VersionName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.VersionProperties != nil), nil
+}
+
+func (TemplateSpecVersionResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_template_spec" "test" {
+  name                = "acctest-ts-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (r TemplateSpecVersionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_template_spec_version" "test" {
+  name             = "v1.0.0"
+  template_spec_id = azurerm_template_spec.test.id
+
+  template_body = <<TEMPLATE
+{
+  "$schema": "https://schema.management.azure.com/schemas/2019-04-01/deploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "parameters": {},
+  "variables": {},
+  "resources": []
+}
+TEMPLATE
+}
+`, r.template(data))
+}
+
+func (r TemplateSpecVersionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_template_spec_version" "import" {
+  name             = azurerm_template_spec_version.test.name
+  template_spec_id = azurerm_template_spec_version.test.template_spec_id
+  template_body    = azurerm_template_spec_version.test.template_body
+}
+`, r.basic(data))
+}
+
+func (r TemplateSpecVersionResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_template_spec_version" "test" {
+  name             = "v1.0.0"
+  template_spec_id = azurerm_template_spec.test.id
+  description      = "Acceptance Test Template Spec Version"
+
+  template_body = <<TEMPLATE
+{
+  "$schema": "https://schema.management.azure.com/schemas/2019-04-01/deploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "parameters": {},
+  "variables": {},
+  "resources": [
+    {
+      "type": "Microsoft.Resources/deployments",
+      "apiVersion": "2020-10-01",
+      "name": "linked",
+      "properties": {
+        "mode": "Incremental",
+        "templateLink": {
+          "relativePath": "artifacts/linked.json"
+        }
+      }
+    }
+  ]
+}
+TEMPLATE
+
+  linked_templates {
+    path = "artifacts/linked.json"
+
+    template_body = <<TEMPLATE
+{
+  "$schema": "https://schema.management.azure.com/schemas/2019-04-01/deploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "parameters": {},
+  "variables": {},
+  "resources": []
+}
+TEMPLATE
+  }
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, r.template(data))
+}
+
+func (r TemplateSpecVersionResource) multipleVersions(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_template_spec_version" "second" {
+  name             = "v2.0.0"
+  template_spec_id = azurerm_template_spec.test.id
+  description      = "Version 2"
+  template_body    = azurerm_template_spec_version.test.template_body
+}
+
+data "azurerm_template_spec_version" "test" {
+  name                = azurerm_template_spec.test.name
+  resource_group_name = azurerm_template_spec.test.resource_group_name
+  version             = azurerm_template_spec_version.second.name
+}
+`, r.basic(data))
+}
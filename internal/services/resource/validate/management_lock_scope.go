@@ -0,0 +1,34 @@
+package validate
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+)
+
+// ManagementLockScope validates that the scope of a Management Lock is a Subscription, Resource Group or Resource ID.
+// Management Locks can't be applied to Management Groups, so these are rejected with an explicit error.
+func ManagementLockScope(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if strings.HasPrefix(strings.ToLower(v), "/providers/microsoft.management/managementgroups/") {
+		errors = append(errors, fmt.Errorf("%q must be a Subscription, Resource Group or Resource ID - Management Locks are not supported at the Management Group scope, got %q", k, v))
+		return
+	}
+
+	if !strings.HasPrefix(strings.ToLower(v), "/subscriptions/") {
+		errors = append(errors, fmt.Errorf("%q must be a Subscription, Resource Group or Resource ID, got %q", k, v))
+		return
+	}
+
+	if _, err := azure.ParseAzureResourceID(v); err != nil {
+		errors = append(errors, fmt.Errorf("parsing %q as a Resource ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,30 @@
+package validate
+
+import "testing"
+
+func TestManagementLockScope(t *testing.T) {
+	testCases := []struct {
+		input       string
+		shouldError bool
+	}{
+		{"", true},
+		{"subscriptions/12345678-1234-9876-4563-123456789012", true},
+		{"/subscriptions/12345678-1234-9876-4563-123456789012", false},
+		{"/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1", false},
+		{"/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/publicIPAddresses/ip1", false},
+		{"/providers/Microsoft.Management/managementGroups/group1", true},
+		{"/PROVIDERS/MICROSOFT.MANAGEMENT/MANAGEMENTGROUPS/group1", true},
+	}
+
+	for _, test := range testCases {
+		_, es := ManagementLockScope(test.input, "scope")
+
+		if test.shouldError && len(es) == 0 {
+			t.Fatalf("Expected validating scope %q to fail", test.input)
+		}
+
+		if !test.shouldError && len(es) > 0 {
+			t.Fatalf("Expected validating scope %q to pass but got %+v", test.input, es)
+		}
+	}
+}
@@ -0,0 +1,19 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// ResourceProviderNamespace validates the format of a Resource Provider Namespace, e.g. `Microsoft.ServiceFabricMesh`
+func ResourceProviderNamespace(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+	if !regexp.MustCompile(`^[A-Za-z0-9]+(\.[A-Za-z0-9]+)+$`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%q must be a Resource Provider Namespace in the format `Publisher.Service` (e.g. `Microsoft.ServiceFabricMesh`), got %q", key, v))
+	}
+	return
+}
@@ -0,0 +1,80 @@
+package validate
+
+import "testing"
+
+func TestResourceProviderNamespace(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			// empty
+			Input: "",
+			Valid: false,
+		},
+		{
+			// no service
+			Input: "Microsoft",
+			Valid: false,
+		},
+		{
+			// trailing period
+			Input: "Microsoft.",
+			Valid: false,
+		},
+		{
+			// leading period
+			Input: ".ServiceFabricMesh",
+			Valid: false,
+		},
+		{
+			// consecutive periods
+			Input: "Microsoft..ServiceFabricMesh",
+			Valid: false,
+		},
+		{
+			// invalid char
+			Input: "Microsoft.Service-Fabric",
+			Valid: false,
+		},
+		{
+			// resource provider id
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.ServiceFabricMesh",
+			Valid: false,
+		},
+		{
+			// sensible value
+			Input: "Microsoft.ServiceFabricMesh",
+			Valid: true,
+		},
+		{
+			// lower-cased
+			Input: "microsoft.compute",
+			Valid: true,
+		},
+		{
+			// third-party publisher
+			Input: "Dynatrace.Observability",
+			Valid: true,
+		},
+		{
+			// publisher starting with a digit
+			Input: "84codes.CloudAMQP",
+			Valid: true,
+		},
+		{
+			// multiple segments
+			Input: "Microsoft.Azure.Storage",
+			Valid: true,
+		},
+	}
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %s", tc.Input)
+		_, errors := ResourceProviderNamespace(tc.Input, "name")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t", tc.Valid, valid)
+		}
+	}
+}
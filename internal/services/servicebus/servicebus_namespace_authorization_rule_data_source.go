@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/Azure/azure-sdk-for-go/services/preview/servicebus/mgmt/2021-06-01-preview/servicebus"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicebus/parse"
@@ -35,6 +36,21 @@ func dataSourceServiceBusNamespaceAuthorizationRule() *pluginsdk.Resource {
 
 			"resource_group_name": commonschema.ResourceGroupNameForDataSource(),
 
+			"listen": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
+			"send": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
+			"manage": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
 			"primary_key": {
 				Type:      pluginsdk.TypeString,
 				Computed:  true,
@@ -70,6 +86,47 @@ func dataSourceServiceBusNamespaceAuthorizationRule() *pluginsdk.Resource {
 				Computed:  true,
 				Sensitive: true,
 			},
+
+			"has_disaster_recovery_alias": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
+			"metric_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"local_authentication_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
+			"network_rule_set": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"default_action": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"ip_rules": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"trusted_service_access_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -95,7 +152,26 @@ func dataSourceServiceBusNamespaceAuthorizationRuleRead(d *pluginsdk.ResourceDat
 		return fmt.Errorf("listing keys for %s: %+v", id, err)
 	}
 
+	namespace, err := client.Get(ctx, id.ResourceGroup, id.NamespaceName)
+	if err != nil {
+		return fmt.Errorf("retrieving Namespace for %s: %+v", id, err)
+	}
+
+	// older namespaces may not have a network rule set, in which case the block is left empty
+	networkRuleSet, err := client.GetNetworkRuleSet(ctx, id.ResourceGroup, id.NamespaceName)
+	if err != nil && !utils.ResponseWasNotFound(networkRuleSet.Response) {
+		return fmt.Errorf("retrieving Network Rule Set for %s: %+v", id, err)
+	}
+
 	d.SetId(id.ID())
+
+	if properties := resp.SBAuthorizationRuleProperties; properties != nil {
+		listen, send, manage := flattenAuthorizationRuleRights(properties.Rights)
+		d.Set("listen", listen)
+		d.Set("send", send)
+		d.Set("manage", manage)
+	}
+
 	d.Set("primary_key", keysResp.PrimaryKey)
 	d.Set("primary_connection_string", keysResp.PrimaryConnectionString)
 	d.Set("secondary_key", keysResp.SecondaryKey)
@@ -103,5 +179,46 @@ func dataSourceServiceBusNamespaceAuthorizationRuleRead(d *pluginsdk.ResourceDat
 	d.Set("primary_connection_string_alias", keysResp.AliasPrimaryConnectionString)
 	d.Set("secondary_connection_string_alias", keysResp.AliasSecondaryConnectionString)
 
+	// the alias connection strings are only returned when the Namespace is Geo-DR paired using an alias
+	hasDisasterRecoveryAlias := (keysResp.AliasPrimaryConnectionString != nil && *keysResp.AliasPrimaryConnectionString != "") ||
+		(keysResp.AliasSecondaryConnectionString != nil && *keysResp.AliasSecondaryConnectionString != "")
+	d.Set("has_disaster_recovery_alias", hasDisasterRecoveryAlias)
+
+	metricId := ""
+	localAuthenticationEnabled := true
+	if props := namespace.SBNamespaceProperties; props != nil {
+		if props.MetricID != nil {
+			metricId = *props.MetricID
+		}
+		if props.DisableLocalAuth != nil {
+			localAuthenticationEnabled = !*props.DisableLocalAuth
+		}
+	}
+	d.Set("metric_id", metricId)
+	d.Set("local_authentication_enabled", localAuthenticationEnabled)
+
+	if err := d.Set("network_rule_set", flattenServiceBusNamespaceAuthorizationRuleNetworkRuleSet(networkRuleSet.NetworkRuleSetProperties)); err != nil {
+		return fmt.Errorf("setting `network_rule_set`: %+v", err)
+	}
+
 	return nil
 }
+
+func flattenServiceBusNamespaceAuthorizationRuleNetworkRuleSet(input *servicebus.NetworkRuleSetProperties) []interface{} {
+	if input == nil {
+		return make([]interface{}, 0)
+	}
+
+	trustedServiceAccessEnabled := false
+	if input.TrustedServiceAccessEnabled != nil {
+		trustedServiceAccessEnabled = *input.TrustedServiceAccessEnabled
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"default_action":                 string(input.DefaultAction),
+			"ip_rules":                       flattenServiceBusNamespaceIPRules(input.IPRules),
+			"trusted_service_access_enabled": trustedServiceAccessEnabled,
+		},
+	}
+}
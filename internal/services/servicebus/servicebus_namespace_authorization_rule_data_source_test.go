@@ -25,6 +25,13 @@ func TestAccDataSourceServiceBusNamespaceAuthorizationRule_basic(t *testing.T) {
 				check.That(data.ResourceName).Key("secondary_key").Exists(),
 				check.That(data.ResourceName).Key("primary_connection_string_alias").HasValue(""),
 				check.That(data.ResourceName).Key("secondary_connection_string_alias").HasValue(""),
+				check.That(data.ResourceName).Key("has_disaster_recovery_alias").HasValue("false"),
+				check.That(data.ResourceName).Key("network_rule_set.#").Exists(),
+				check.That(data.ResourceName).Key("metric_id").IsSet(),
+				check.That(data.ResourceName).Key("local_authentication_enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("listen").HasValue("true"),
+				check.That(data.ResourceName).Key("send").HasValue("true"),
+				check.That(data.ResourceName).Key("manage").HasValue("true"),
 			),
 		},
 	})
@@ -45,6 +52,7 @@ func TestAccDataSourceServiceBusNamespaceAuthorizationRule_withAliasConnectionSt
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).Key("primary_connection_string_alias").Exists(),
 				check.That(data.ResourceName).Key("secondary_connection_string_alias").Exists(),
+				check.That(data.ResourceName).Key("has_disaster_recovery_alias").HasValue("true"),
 			),
 		},
 	})
@@ -0,0 +1,112 @@
+package servicebus
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	keyVaultParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicebus/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicebus/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func dataSourceServiceBusNamespaceAuthorizationRuleKeyVaultReference() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceServiceBusNamespaceAuthorizationRuleKeyVaultReferenceRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"namespace_authorization_rule_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NamespaceAuthorizationRuleID,
+			},
+
+			"key_vault_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: keyVaultValidate.VaultID,
+			},
+
+			// defaults to the name of the Authorization Rule when it's a valid Key Vault Secret name
+			"key_vault_secret_name": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 127),
+					keyVaultValidate.NestedItemName,
+				),
+			},
+
+			"key_vault_reference": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"primary_connection_string": {
+				Type:      pluginsdk.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func dataSourceServiceBusNamespaceAuthorizationRuleKeyVaultReferenceRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ServiceBus.NamespacesClient
+	keyVaultsClient := meta.(*clients.Client).KeyVault
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NamespaceAuthorizationRuleID(d.Get("namespace_authorization_rule_id").(string))
+	if err != nil {
+		return err
+	}
+
+	keyVaultId, err := keyVaultParse.VaultID(d.Get("key_vault_id").(string))
+	if err != nil {
+		return err
+	}
+
+	secretName := d.Get("key_vault_secret_name").(string)
+	if secretName == "" {
+		if _, errs := keyVaultValidate.NestedItemName(id.AuthorizationRuleName, "key_vault_secret_name"); len(errs) > 0 {
+			return fmt.Errorf("the name of %s isn't a valid Key Vault Secret name, `key_vault_secret_name` must be specified", id)
+		}
+		secretName = id.AuthorizationRuleName
+	}
+
+	keysResp, err := client.ListKeys(ctx, id.ResourceGroup, id.NamespaceName, id.AuthorizationRuleName)
+	if err != nil {
+		if utils.ResponseWasNotFound(keysResp.Response) {
+			return fmt.Errorf("%s was not found", id)
+		}
+		return fmt.Errorf("listing keys for %s: %+v", id, err)
+	}
+
+	keyVaultBaseUri, err := keyVaultsClient.BaseUriForKeyVault(ctx, *keyVaultId)
+	if err != nil {
+		return fmt.Errorf("looking up the Base URI for %s: %+v", keyVaultId, err)
+	}
+
+	secretId, err := keyVaultParse.NewNestedItemID(*keyVaultBaseUri, "secrets", secretName, "")
+	if err != nil {
+		return err
+	}
+
+	d.SetId(id.ID())
+	d.Set("key_vault_secret_name", secretName)
+	d.Set("key_vault_reference", fmt.Sprintf("@Microsoft.KeyVault(SecretUri=%s/)", secretId.VersionlessID()))
+	d.Set("primary_connection_string", keysResp.PrimaryConnectionString)
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package servicebus_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type ServiceBusNamespaceAuthorizationRuleKeyVaultReferenceDataSource struct{}
+
+func TestAccDataSourceServiceBusNamespaceAuthorizationRuleKeyVaultReference_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_servicebus_namespace_authorization_rule_key_vault_reference", "test")
+	r := ServiceBusNamespaceAuthorizationRuleKeyVaultReferenceDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("key_vault_secret_name").HasValue(fmt.Sprintf("acctest-%d", data.RandomInteger)),
+				check.That(data.ResourceName).Key("key_vault_reference").MatchesRegex(regexp.MustCompile(`^@Microsoft\.KeyVault\(SecretUri=https://acctestkv.+/secrets/acctest-\d+/\)$`)),
+				check.That(data.ResourceName).Key("primary_connection_string").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceServiceBusNamespaceAuthorizationRuleKeyVaultReference_secretName(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_servicebus_namespace_authorization_rule_key_vault_reference", "test")
+	r := ServiceBusNamespaceAuthorizationRuleKeyVaultReferenceDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.secretName(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("key_vault_secret_name").HasValue("servicebus-connection-string"),
+				check.That(data.ResourceName).Key("key_vault_reference").MatchesRegex(regexp.MustCompile(`^@Microsoft\.KeyVault\(SecretUri=https://acctestkv.+/secrets/servicebus-connection-string/\)$`)),
+			),
+		},
+	})
+}
+
+func (ServiceBusNamespaceAuthorizationRuleKeyVaultReferenceDataSource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_key_vault" "test" {
+  name                = "acctestkv%s"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  tenant_id           = data.azurerm_client_config.current.tenant_id
+  sku_name            = "standard"
+}
+`, ServiceBusNamespaceAuthorizationRuleResource{}.base(data, true, true, false), data.RandomString)
+}
+
+func (r ServiceBusNamespaceAuthorizationRuleKeyVaultReferenceDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_servicebus_namespace_authorization_rule_key_vault_reference" "test" {
+  namespace_authorization_rule_id = azurerm_servicebus_namespace_authorization_rule.test.id
+  key_vault_id                    = azurerm_key_vault.test.id
+}
+`, r.template(data))
+}
+
+func (r ServiceBusNamespaceAuthorizationRuleKeyVaultReferenceDataSource) secretName(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_servicebus_namespace_authorization_rule_key_vault_reference" "test" {
+  namespace_authorization_rule_id = azurerm_servicebus_namespace_authorization_rule.test.id
+  key_vault_id                    = azurerm_key_vault.test.id
+  key_vault_secret_name           = "servicebus-connection-string"
+}
+`, r.template(data))
+}
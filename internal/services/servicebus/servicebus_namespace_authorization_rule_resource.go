@@ -1,6 +1,7 @@
 package servicebus
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -12,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicebus/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicebus/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -75,9 +77,28 @@ func resourceServiceBusNamespaceAuthorizationRule() *pluginsdk.Resource {
 				Deprecated:    `Deprecated in favor of "namespace_id"`,
 				ConflictsWith: []string{"namespace_id"},
 			},
+
+			"regenerate_key_type": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  string(servicebus.KeyTypePrimaryKey),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(servicebus.KeyTypePrimaryKey),
+					string(servicebus.KeyTypeSecondaryKey),
+				}, false),
+			},
+
+			// changing any value within this map regenerates the key specified in `regenerate_key_type`
+			"regenerate_trigger": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
 		}),
 
-		CustomizeDiff: pluginsdk.CustomizeDiffShim(authorizationRuleCustomizeDiff),
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceServiceBusNamespaceAuthorizationRuleCustomizeDiff),
 	}
 }
 
@@ -123,6 +144,15 @@ func resourceServiceBusNamespaceAuthorizationRuleCreateUpdate(d *pluginsdk.Resou
 
 	d.SetId(resourceId.ID())
 
+	if !d.IsNewResource() && d.HasChange("regenerate_trigger") {
+		regenerateParameters := servicebus.RegenerateAccessKeyParameters{
+			KeyType: servicebus.KeyType(d.Get("regenerate_key_type").(string)),
+		}
+		if _, err := client.RegenerateKeys(ctx, resourceId.ResourceGroup, resourceId.NamespaceName, resourceId.AuthorizationRuleName, regenerateParameters); err != nil {
+			return fmt.Errorf("regenerating %s for %s: %+v", regenerateParameters.KeyType, resourceId, err)
+		}
+	}
+
 	if err := waitForPairedNamespaceReplication(ctx, meta, resourceId.ResourceGroup, resourceId.NamespaceName, d.Timeout(pluginsdk.TimeoutUpdate)); err != nil {
 		return fmt.Errorf("waiting for replication to complete for Service Bus Namespace Disaster Recovery Configs (Namespace %q / Resource Group %q): %s", resourceId.NamespaceName, resourceId.ResourceGroup, err)
 	}
@@ -159,6 +189,13 @@ func resourceServiceBusNamespaceAuthorizationRuleRead(d *pluginsdk.ResourceData,
 	d.Set("resource_group_name", id.ResourceGroup)
 	d.Set("namespace_id", parse.NewNamespaceID(id.SubscriptionId, id.ResourceGroup, id.NamespaceName).ID())
 
+	// `regenerate_key_type` isn't returned by the API, so default it when importing
+	regenerateKeyType := string(servicebus.KeyTypePrimaryKey)
+	if v, ok := d.GetOk("regenerate_key_type"); ok {
+		regenerateKeyType = v.(string)
+	}
+	d.Set("regenerate_key_type", regenerateKeyType)
+
 	if properties := resp.SBAuthorizationRuleProperties; properties != nil {
 		listen, send, manage := flattenAuthorizationRuleRights(properties.Rights)
 		d.Set("manage", manage)
@@ -196,3 +233,25 @@ func resourceServiceBusNamespaceAuthorizationRuleDelete(d *pluginsdk.ResourceDat
 
 	return nil
 }
+
+func resourceServiceBusNamespaceAuthorizationRuleCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+	if err := authorizationRuleCustomizeDiff(ctx, d, v); err != nil {
+		return err
+	}
+
+	// the regenerated key (and the connection strings derived from it) aren't known until apply
+	if d.Id() != "" && d.HasChange("regenerate_trigger") {
+		keyAttributes := []string{"primary_key", "primary_connection_string", "primary_connection_string_alias"}
+		if d.Get("regenerate_key_type").(string) == string(servicebus.KeyTypeSecondaryKey) {
+			keyAttributes = []string{"secondary_key", "secondary_connection_string", "secondary_connection_string_alias"}
+		}
+
+		for _, attribute := range keyAttributes {
+			if err := d.SetNewComputed(attribute); err != nil {
+				return fmt.Errorf("setting `%s` to computed: %+v", attribute, err)
+			}
+		}
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -81,6 +82,39 @@ func TestAccServiceBusNamespaceAuthorizationRule_rightsUpdate(t *testing.T) {
 	})
 }
 
+func TestAccServiceBusNamespaceAuthorizationRule_regenerateKeys(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_servicebus_namespace_authorization_rule", "test")
+	r := ServiceBusNamespaceAuthorizationRuleResource{}
+
+	keys := make(map[string]string)
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.regenerateKeys(data, "PrimaryKey", "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				r.checkKeysRegenerated(data, keys, false, false),
+			),
+		},
+		data.ImportStep("regenerate_trigger"),
+		{
+			Config: r.regenerateKeys(data, "PrimaryKey", "second"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				r.checkKeysRegenerated(data, keys, true, false),
+			),
+		},
+		data.ImportStep("regenerate_trigger"),
+		{
+			Config: r.regenerateKeys(data, "SecondaryKey", "third"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				r.checkKeysRegenerated(data, keys, false, true),
+			),
+		},
+		data.ImportStep("regenerate_trigger", "regenerate_key_type"),
+	})
+}
+
 func TestAccServiceBusNamespaceAuthorizationRule_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_servicebus_namespace_authorization_rule", "test")
 	r := ServiceBusNamespaceAuthorizationRuleResource{}
@@ -160,6 +194,63 @@ resource "azurerm_servicebus_namespace_authorization_rule" "import" {
 `, r.base(data, listen, send, manage))
 }
 
+// checkKeysRegenerated compares the keys in the state with those from the previous step (if any), and then
+// records the current keys for the next step
+func (ServiceBusNamespaceAuthorizationRuleResource) checkKeysRegenerated(data acceptance.TestData, keys map[string]string, primaryChanged, secondaryChanged bool) pluginsdk.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[data.ResourceName]
+		if !ok {
+			return fmt.Errorf("%q was not found in the state", data.ResourceName)
+		}
+
+		expected := map[string]bool{
+			"primary_key":   primaryChanged,
+			"secondary_key": secondaryChanged,
+		}
+		for key, changed := range expected {
+			current := rs.Primary.Attributes[key]
+			if previous, ok := keys[key]; ok && (previous != current) != changed {
+				return fmt.Errorf("expected `%s` to have been regenerated: %t", key, changed)
+			}
+			keys[key] = current
+		}
+
+		return nil
+	}
+}
+
+func (ServiceBusNamespaceAuthorizationRuleResource) regenerateKeys(data acceptance.TestData, keyType, trigger string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_servicebus_namespace" "test" {
+  name                = "acctest-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Standard"
+}
+
+resource "azurerm_servicebus_namespace_authorization_rule" "test" {
+  name         = "acctest-%[1]d"
+  namespace_id = azurerm_servicebus_namespace.test.id
+
+  listen = true
+
+  regenerate_key_type = "%[3]s"
+  regenerate_trigger = {
+    rotation = "%[4]s"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, keyType, trigger)
+}
+
 func (ServiceBusNamespaceAuthorizationRuleResource) withAliasConnectionString(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
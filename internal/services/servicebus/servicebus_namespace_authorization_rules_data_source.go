@@ -0,0 +1,129 @@
+package servicebus
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/servicebus/mgmt/2021-06-01-preview/servicebus"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicebus/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicebus/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func dataSourceServiceBusNamespaceAuthorizationRules() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceServiceBusNamespaceAuthorizationRulesRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"namespace_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NamespaceName,
+			},
+
+			"resource_group_name": commonschema.ResourceGroupNameForDataSource(),
+
+			"authorization_rules": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"listen": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+
+						"send": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+
+						"manage": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceServiceBusNamespaceAuthorizationRulesRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ServiceBus.NamespacesClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewNamespaceID(subscriptionId, d.Get("resource_group_name").(string), d.Get("namespace_name").(string))
+
+	iterator, err := client.ListAuthorizationRulesComplete(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(iterator.Response().Response) {
+			return fmt.Errorf("%s was not found", id)
+		}
+
+		return fmt.Errorf("listing Authorization Rules for %s: %+v", id, err)
+	}
+
+	authorizationRules, err := flattenServiceBusNamespaceAuthorizationRules(ctx, iterator)
+	if err != nil {
+		return fmt.Errorf("listing Authorization Rules for %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	d.Set("namespace_name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+
+	if err := d.Set("authorization_rules", authorizationRules); err != nil {
+		return fmt.Errorf("setting `authorization_rules`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenServiceBusNamespaceAuthorizationRules(ctx context.Context, iterator servicebus.SBAuthorizationRuleListResultIterator) ([]interface{}, error) {
+	results := make([]interface{}, 0)
+
+	for iterator.NotDone() {
+		rule := iterator.Value()
+
+		name := ""
+		if rule.Name != nil {
+			name = *rule.Name
+		}
+
+		var listen, send, manage bool
+		if props := rule.SBAuthorizationRuleProperties; props != nil {
+			listen, send, manage = flattenAuthorizationRuleRights(props.Rights)
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":   name,
+			"listen": listen,
+			"send":   send,
+			"manage": manage,
+		})
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return results, nil
+}
@@ -0,0 +1,40 @@
+package servicebus_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type ServiceBusNamespaceAuthorizationRulesDataSource struct{}
+
+func TestAccDataSourceServiceBusNamespaceAuthorizationRules_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_servicebus_namespace_authorization_rules", "test")
+	r := ServiceBusNamespaceAuthorizationRulesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("id").Exists(),
+				// the `RootManageSharedAccessKey` rule is created alongside the Namespace
+				check.That(data.ResourceName).Key("authorization_rules.#").HasValue("2"),
+			),
+		},
+	})
+}
+
+func (ServiceBusNamespaceAuthorizationRulesDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_servicebus_namespace_authorization_rules" "test" {
+  namespace_name      = azurerm_servicebus_namespace.test.name
+  resource_group_name = azurerm_resource_group.test.name
+
+  depends_on = [azurerm_servicebus_namespace_authorization_rule.test]
+}
+`, ServiceBusNamespaceAuthorizationRuleResource{}.base(data, true, true, false))
+}
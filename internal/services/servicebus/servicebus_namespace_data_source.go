@@ -5,9 +5,11 @@ import (
 	"log"
 	"time"
 
+	"github.com/Azure/azure-sdk-for-go/services/preview/servicebus/mgmt/2021-06-01-preview/servicebus"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	keyVaultParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicebus/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -75,6 +77,29 @@ func dataSourceServiceBusNamespace() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"customer_managed_key": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"key_vault_key_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"identity_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"infrastructure_encryption_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+
 			"tags": tags.SchemaDataSource(),
 		},
 	}
@@ -102,11 +127,21 @@ func dataSourceServiceBusNamespaceRead(d *pluginsdk.ResourceData, meta interface
 
 	if sku := resp.Sku; sku != nil {
 		d.Set("sku", string(sku.Name))
-		d.Set("capacity", sku.Capacity)
 	}
 
+	capacity, zoneRedundant := flattenServiceBusNamespaceDataSourcePremiumProperties(resp)
+	d.Set("capacity", capacity)
+	d.Set("zone_redundant", zoneRedundant)
+
+	customerManagedKey := make([]interface{}, 0)
 	if properties := resp.SBNamespaceProperties; properties != nil {
-		d.Set("zone_redundant", properties.ZoneRedundant)
+		customerManagedKey, err = flattenServiceBusNamespaceDataSourceCustomerManagedKey(properties.Encryption)
+		if err != nil {
+			return fmt.Errorf("flattening `customer_managed_key`: %+v", err)
+		}
+	}
+	if err := d.Set("customer_managed_key", customerManagedKey); err != nil {
+		return fmt.Errorf("setting `customer_managed_key`: %+v", err)
 	}
 
 	keys, err := clientStable.ListKeys(ctx, id.ResourceGroup, id.Name, serviceBusNamespaceDefaultAuthorizationRule)
@@ -121,3 +156,67 @@ func dataSourceServiceBusNamespaceRead(d *pluginsdk.ResourceData, meta interface
 
 	return nil
 }
+
+// flattenServiceBusNamespaceDataSourcePremiumProperties returns the messaging units and zone redundancy of
+// the namespace - which are only meaningful for Premium namespaces, so are zero/false for the other SKUs
+func flattenServiceBusNamespaceDataSourcePremiumProperties(input servicebus.SBNamespace) (int, bool) {
+	if input.Sku == nil || input.Sku.Name != servicebus.SkuNamePremium {
+		return 0, false
+	}
+
+	capacity := 0
+	if input.Sku.Capacity != nil {
+		capacity = int(*input.Sku.Capacity)
+	}
+
+	zoneRedundant := false
+	if props := input.SBNamespaceProperties; props != nil && props.ZoneRedundant != nil {
+		zoneRedundant = *props.ZoneRedundant
+	}
+
+	return capacity, zoneRedundant
+}
+
+func flattenServiceBusNamespaceDataSourceCustomerManagedKey(input *servicebus.Encryption) ([]interface{}, error) {
+	// namespaces using Microsoft-managed keys don't return any Key Vault properties
+	if input == nil || input.KeyVaultProperties == nil || len(*input.KeyVaultProperties) == 0 {
+		return []interface{}{}, nil
+	}
+
+	// whilst the API returns a list, only a single key can be configured for a namespace
+	item := (*input.KeyVaultProperties)[0]
+
+	var keyName, keyVaultUri, keyVersion string
+	if item.KeyName != nil {
+		keyName = *item.KeyName
+	}
+	if item.KeyVaultURI != nil {
+		keyVaultUri = *item.KeyVaultURI
+	}
+	if item.KeyVersion != nil {
+		keyVersion = *item.KeyVersion
+	}
+
+	keyVaultKeyId, err := keyVaultParse.NewNestedItemID(keyVaultUri, "keys", keyName, keyVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	identityId := ""
+	if item.Identity != nil && item.Identity.UserAssignedIdentity != nil {
+		identityId = *item.Identity.UserAssignedIdentity
+	}
+
+	infrastructureEncryptionEnabled := false
+	if input.RequireInfrastructureEncryption != nil {
+		infrastructureEncryptionEnabled = *input.RequireInfrastructureEncryption
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"key_vault_key_id":                  keyVaultKeyId.ID(),
+			"identity_id":                       identityId,
+			"infrastructure_encryption_enabled": infrastructureEncryptionEnabled,
+		},
+	}, nil
+}
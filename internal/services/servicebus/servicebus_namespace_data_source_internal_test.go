@@ -0,0 +1,82 @@
+package servicebus
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/servicebus/mgmt/2021-06-01-preview/servicebus"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func TestFlattenServiceBusNamespaceDataSourcePremiumProperties(t *testing.T) {
+	testData := []struct {
+		name                  string
+		input                 servicebus.SBNamespace
+		expectedCapacity      int
+		expectedZoneRedundant bool
+	}{
+		{
+			name:  "no sku",
+			input: servicebus.SBNamespace{},
+		},
+		{
+			name: "basic",
+			input: servicebus.SBNamespace{
+				Sku: &servicebus.SBSku{
+					Name:     servicebus.SkuNameBasic,
+					Capacity: utils.Int32(0),
+				},
+				SBNamespaceProperties: &servicebus.SBNamespaceProperties{
+					ZoneRedundant: utils.Bool(false),
+				},
+			},
+		},
+		{
+			name: "standard reporting a capacity",
+			input: servicebus.SBNamespace{
+				Sku: &servicebus.SBSku{
+					Name:     servicebus.SkuNameStandard,
+					Capacity: utils.Int32(1),
+				},
+				SBNamespaceProperties: &servicebus.SBNamespaceProperties{
+					ZoneRedundant: utils.Bool(true),
+				},
+			},
+		},
+		{
+			name: "premium",
+			input: servicebus.SBNamespace{
+				Sku: &servicebus.SBSku{
+					Name:     servicebus.SkuNamePremium,
+					Capacity: utils.Int32(4),
+				},
+				SBNamespaceProperties: &servicebus.SBNamespaceProperties{
+					ZoneRedundant: utils.Bool(true),
+				},
+			},
+			expectedCapacity:      4,
+			expectedZoneRedundant: true,
+		},
+		{
+			name: "premium without properties",
+			input: servicebus.SBNamespace{
+				Sku: &servicebus.SBSku{
+					Name:     servicebus.SkuNamePremium,
+					Capacity: utils.Int32(1),
+				},
+			},
+			expectedCapacity: 1,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		capacity, zoneRedundant := flattenServiceBusNamespaceDataSourcePremiumProperties(v.input)
+		if capacity != v.expectedCapacity {
+			t.Fatalf("expected capacity to be %d but got %d", v.expectedCapacity, capacity)
+		}
+		if zoneRedundant != v.expectedZoneRedundant {
+			t.Fatalf("expected zone_redundant to be %t but got %t", v.expectedZoneRedundant, zoneRedundant)
+		}
+	}
+}
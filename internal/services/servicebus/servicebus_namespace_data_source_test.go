@@ -25,6 +25,9 @@ func TestAccDataSourceServiceBusNamespace_basic(t *testing.T) {
 				check.That(data.ResourceName).Key("default_secondary_connection_string").Exists(),
 				check.That(data.ResourceName).Key("default_primary_key").Exists(),
 				check.That(data.ResourceName).Key("default_secondary_key").Exists(),
+				check.That(data.ResourceName).Key("capacity").HasValue("0"),
+				check.That(data.ResourceName).Key("zone_redundant").HasValue("false"),
+				check.That(data.ResourceName).Key("customer_managed_key.#").HasValue("0"),
 			),
 		},
 	})
@@ -40,7 +43,7 @@ func TestAccDataSourceServiceBusNamespace_premium(t *testing.T) {
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).Key("location").Exists(),
 				check.That(data.ResourceName).Key("sku").Exists(),
-				check.That(data.ResourceName).Key("capacity").Exists(),
+				check.That(data.ResourceName).Key("capacity").HasValue("1"),
 				check.That(data.ResourceName).Key("default_primary_connection_string").Exists(),
 				check.That(data.ResourceName).Key("default_secondary_connection_string").Exists(),
 				check.That(data.ResourceName).Key("default_primary_key").Exists(),
@@ -13,18 +13,27 @@ func NamespaceName(i interface{}, k string) (warnings []string, errors []error)
 		return
 	}
 
-	if matched := regexp.MustCompile("^[a-zA-Z][-a-zA-Z0-9]{4,48}[a-zA-Z0-9]$").MatchString(v); !matched {
-		errors = append(errors, fmt.Errorf("%q can contain only letters, numbers, and hyphens. The namespace must start with a letter, and it must end with a letter or number and be between 6 and 50 characters long", k))
-		return
+	if len(v) < 6 || len(v) > 50 {
+		errors = append(errors, fmt.Errorf("%q must be between 6 and 50 characters long, got %d", k, len(v)))
+	}
+
+	if !regexp.MustCompile(`^[-a-zA-Z0-9]*$`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%q can contain only letters, numbers, and hyphens", k))
+	}
+
+	if !regexp.MustCompile(`^[a-zA-Z]`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%q must start with a letter", k))
+	}
+
+	if !regexp.MustCompile(`[a-zA-Z0-9]$`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%q must end with a letter or number", k))
 	}
 
-	// The name cannot end with "-", "-sb" or "-mgmt".
+	// The name cannot end with "-sb" or "-mgmt", since these are reserved.
 	// See more details from link https://docs.microsoft.com/en-us/rest/api/servicebus/create-namespace.
-	illegalSuffixes := []string{"-", "-sb", "-mgmt"}
-	for _, illegalSuffix := range illegalSuffixes {
-		if strings.HasSuffix(v, illegalSuffix) {
-			errors = append(errors, fmt.Errorf("%q cannot end with a hyphen, -sb, or -mgmt", k))
-			return
+	for _, reservedSuffix := range []string{"-sb", "-mgmt"} {
+		if strings.HasSuffix(strings.ToLower(v), reservedSuffix) {
+			errors = append(errors, fmt.Errorf("%q cannot end with the reserved suffix %q", k, reservedSuffix))
 		}
 	}
 
@@ -0,0 +1,109 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNamespaceName(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		errors int
+	}{
+		{
+			name:   "Empty value",
+			input:  "",
+			errors: 3,
+		},
+		{
+			name:   "Valid name",
+			input:  "acctest-namespace1",
+			errors: 0,
+		},
+		{
+			name:   "Invalid name with 5 characters",
+			input:  "abcde",
+			errors: 1,
+		},
+		{
+			name:   "Valid name with 6 characters",
+			input:  "abcdef",
+			errors: 0,
+		},
+		{
+			name:   "Valid name with 50 characters",
+			input:  strings.Repeat("a", 50),
+			errors: 0,
+		},
+		{
+			name:   "Invalid name with 51 characters",
+			input:  strings.Repeat("a", 51),
+			errors: 1,
+		},
+		{
+			name:   "Invalid name starts with a number",
+			input:  "1namespace",
+			errors: 1,
+		},
+		{
+			name:   "Invalid name starts with a hyphen",
+			input:  "-namespace",
+			errors: 1,
+		},
+		{
+			name:   "Invalid name ends with a hyphen",
+			input:  "namespace-",
+			errors: 1,
+		},
+		{
+			name:   "Valid name ends with a number",
+			input:  "namespace1",
+			errors: 0,
+		},
+		{
+			name:   "Invalid name with an underscore",
+			input:  "name_space",
+			errors: 1,
+		},
+		{
+			name:   "Invalid name with the reserved suffix -sb",
+			input:  "namespace-sb",
+			errors: 1,
+		},
+		{
+			name:   "Invalid name with the reserved suffix -SB",
+			input:  "namespace-SB",
+			errors: 1,
+		},
+		{
+			name:   "Invalid name with the reserved suffix -mgmt",
+			input:  "namespace-mgmt",
+			errors: 1,
+		},
+		{
+			name:   "Valid name containing but not ending with -sb",
+			input:  "namespace-sb1",
+			errors: 0,
+		},
+		{
+			name:   "Valid name ending with sb without a hyphen",
+			input:  "namespacesb",
+			errors: 0,
+		},
+		{
+			name:   "Invalid name breaking multiple rules",
+			input:  "1-sb",
+			errors: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, errors := NamespaceName(tt.input, "name")
+			if len(errors) != tt.errors {
+				t.Errorf("Expected %d errors but got %d for input %s: %+v", tt.errors, len(errors), tt.input, errors)
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/servicefabricmesh/mgmt/2018-09-01-preview/servicefabricmesh"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
@@ -50,7 +51,7 @@ func resourceServiceFabricMeshLocalNetwork() *pluginsdk.Resource {
 			// Follow casing issue here https://github.com/Azure/azure-rest-api-specs/issues/9330
 			"resource_group_name": azure.SchemaResourceGroupNameDiffSuppress(),
 
-			"location": azure.SchemaLocation(),
+			"location": commonschema.Location(),
 
 			"network_address_prefix": {
 				Type:         pluginsdk.TypeString,
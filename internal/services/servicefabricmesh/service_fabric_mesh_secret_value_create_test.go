@@ -0,0 +1,158 @@
+package servicefabricmesh
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/servicefabricmesh/mgmt/2018-09-01-preview/servicefabricmesh"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicefabricmesh/parse"
+)
+
+type fakeSecretValueCreateClient struct {
+	statusCodes []int
+	calls       int
+}
+
+func (c *fakeSecretValueCreateClient) Create(_ context.Context, _ string, _ string, _ string, _ servicefabricmesh.SecretValueResourceDescription) (servicefabricmesh.SecretValueResourceDescription, error) {
+	statusCode := c.statusCodes[c.calls]
+	c.calls++
+
+	result := servicefabricmesh.SecretValueResourceDescription{
+		Response: autorest.Response{
+			Response: &http.Response{
+				StatusCode: statusCode,
+			},
+		},
+	}
+	if statusCode != http.StatusOK {
+		return result, fmt.Errorf("unexpected status %d", statusCode)
+	}
+
+	return result, nil
+}
+
+func TestServiceFabricMeshSecretValueCreateRefreshFunc(t *testing.T) {
+	id := parse.NewSecretValueID("00000000-0000-0000-0000-000000000000", "group1", "secret1", "value1")
+
+	tests := []struct {
+		name        string
+		statusCodes []int
+		states      []string
+		expectError bool
+	}{
+		{
+			name:        "Created",
+			statusCodes: []int{http.StatusOK},
+			states:      []string{"Created"},
+		},
+		{
+			name:        "Conflict then Created",
+			statusCodes: []int{http.StatusConflict, http.StatusConflict, http.StatusOK},
+			states:      []string{"Conflict", "Conflict", "Created"},
+		},
+		{
+			name:        "Conflict then Bad Request",
+			statusCodes: []int{http.StatusConflict, http.StatusBadRequest},
+			states:      []string{"Conflict", "Error"},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &fakeSecretValueCreateClient{statusCodes: tt.statusCodes}
+			refresh := serviceFabricMeshSecretValueCreateRefreshFunc(context.TODO(), client, id, servicefabricmesh.SecretValueResourceDescription{})
+
+			var err error
+			for i, expected := range tt.states {
+				var state string
+				_, state, err = refresh()
+				if state != expected {
+					t.Fatalf("expected state %q for call %d but got %q", expected, i+1, state)
+				}
+			}
+
+			if tt.expectError && err == nil {
+				t.Fatalf("expected an error but didn't get one")
+			}
+			if !tt.expectError && err != nil {
+				t.Fatalf("expected no error but got: %+v", err)
+			}
+		})
+	}
+}
+
+type fakeSecretValueGetClient struct {
+	statusCodes []int
+	calls       int
+}
+
+func (c *fakeSecretValueGetClient) Get(_ context.Context, _ string, _ string, _ string) (servicefabricmesh.SecretValueResourceDescription, error) {
+	statusCode := c.statusCodes[c.calls]
+	c.calls++
+
+	result := servicefabricmesh.SecretValueResourceDescription{
+		Response: autorest.Response{
+			Response: &http.Response{
+				StatusCode: statusCode,
+			},
+		},
+	}
+	if statusCode != http.StatusOK {
+		return result, fmt.Errorf("unexpected status %d", statusCode)
+	}
+
+	return result, nil
+}
+
+func TestWaitForServiceFabricMeshSecretValueToExist(t *testing.T) {
+	id := parse.NewSecretValueID("00000000-0000-0000-0000-000000000000", "group1", "secret1", "value1")
+
+	tests := []struct {
+		name          string
+		statusCodes   []int
+		expectedCalls int
+		expectError   bool
+	}{
+		{
+			name:          "Found",
+			statusCodes:   []int{http.StatusOK},
+			expectedCalls: 1,
+		},
+		{
+			name:          "Not Found then Found",
+			statusCodes:   []int{http.StatusNotFound, http.StatusNotFound, http.StatusOK},
+			expectedCalls: 3,
+		},
+		{
+			name:          "Not Found then Internal Server Error",
+			statusCodes:   []int{http.StatusNotFound, http.StatusInternalServerError},
+			expectedCalls: 2,
+			expectError:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.TODO(), time.Minute)
+			defer cancel()
+
+			client := &fakeSecretValueGetClient{statusCodes: tt.statusCodes}
+			err := waitForServiceFabricMeshSecretValueToExist(ctx, client, id, time.Millisecond)
+
+			if client.calls != tt.expectedCalls {
+				t.Fatalf("expected %d calls to Get but got %d", tt.expectedCalls, client.calls)
+			}
+			if tt.expectError && err == nil {
+				t.Fatalf("expected an error but didn't get one")
+			}
+			if !tt.expectError && err != nil {
+				t.Fatalf("expected no error but got: %+v", err)
+			}
+		})
+	}
+}
@@ -1,12 +1,14 @@
 package servicefabricmesh
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/servicefabricmesh/mgmt/2018-09-01-preview/servicefabricmesh"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
@@ -26,10 +28,10 @@ func resourceServiceFabricMeshSecretValue() *pluginsdk.Resource {
 		Read:   resourceServiceFabricMeshSecretValueRead,
 		Update: resourceServiceFabricMeshSecretValueCreateUpdate,
 		Delete: resourceServiceFabricMeshSecretValueDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
 			_, err := parse.SecretValueID(id)
 			return err
-		}),
+		}, importServiceFabricMeshSecretValue),
 
 		DeprecationMessage: deprecationMessage("azurerm_service_fabric_mesh_secret_value"),
 
@@ -59,20 +61,42 @@ func resourceServiceFabricMeshSecretValue() *pluginsdk.Resource {
 				ValidateFunc:     azure.ValidateResourceID,
 			},
 
-			"location": azure.SchemaLocation(),
+			"location": commonschema.Location(),
 
 			"value": {
 				Type:         pluginsdk.TypeString,
 				Required:     true,
 				ForceNew:     true,
+				Sensitive:    true,
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 
-			"tags": tags.Schema(),
+			"tags": tags.SchemaIgnoringSystemTags(),
 		},
 	}
 }
 
+// importServiceFabricMeshSecretValue checks that the parent Secret exists, since otherwise the Read function would
+// silently remove the Secret Value from the state, leaving a half-imported resource
+func importServiceFabricMeshSecretValue(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
+	id, err := parse.SecretValueID(d.Id())
+	if err != nil {
+		return []*pluginsdk.ResourceData{}, err
+	}
+
+	secretClient := meta.(*clients.Client).ServiceFabricMesh.SecretClient
+	secret, err := secretClient.Get(ctx, id.ResourceGroup, id.SecretName)
+	if err != nil {
+		if utils.ResponseWasNotFound(secret.Response) {
+			return []*pluginsdk.ResourceData{}, fmt.Errorf("the parent Service Fabric Mesh Secret %q (Resource Group %q) of %s no longer exists", id.SecretName, id.ResourceGroup, id)
+		}
+
+		return []*pluginsdk.ResourceData{}, fmt.Errorf("retrieving the parent Service Fabric Mesh Secret %q (Resource Group %q): %+v", id.SecretName, id.ResourceGroup, err)
+	}
+
+	return []*pluginsdk.ResourceData{d}, nil
+}
+
 func resourceServiceFabricMeshSecretValueCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).ServiceFabricMesh.SecretValueClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
@@ -108,10 +132,30 @@ func resourceServiceFabricMeshSecretValueCreateUpdate(d *pluginsdk.ResourceData,
 		Tags:     tags.Expand(t),
 	}
 
-	if _, err := client.Create(ctx, id.ResourceGroup, id.SecretName, id.ValueName, parameters); err != nil {
+	// the parent Secret is briefly locked whilst another Value is being created within it, so creating Values
+	// in parallel intermittently returns a 409 Conflict - which we retry until the timeout is reached
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("context had no deadline")
+	}
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending:    []string{"Conflict"},
+		Target:     []string{"Created"},
+		Refresh:    serviceFabricMeshSecretValueCreateRefreshFunc(ctx, client, id, parameters),
+		MinTimeout: 10 * time.Second,
+		Timeout:    time.Until(deadline),
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return fmt.Errorf("creating %s: %+v", id, err)
 	}
 
+	// whilst Create isn't a long-running operation the API is eventually consistent, so the Secret Value isn't
+	// always immediately retrievable - meaning we need to wait for it to exist before the Read removes it from the state
+	if err := waitForServiceFabricMeshSecretValueToExist(ctx, client, id, serviceFabricMeshSecretValueCreatePollInterval); err != nil {
+		return fmt.Errorf("waiting for %s to be created: %+v", id, err)
+	}
+
 	d.SetId(id.ID())
 
 	return resourceServiceFabricMeshSecretValueRead(d, meta)
@@ -154,6 +198,8 @@ func resourceServiceFabricMeshSecretValueRead(d *pluginsdk.ResourceData, meta in
 	d.Set("service_fabric_mesh_secret_id", secret.ID)
 	d.Set("location", location.NormalizeNilable(resp.Location))
 
+	// the API doesn't return the secret `value`, so this is intentionally not set to avoid a perpetual diff
+
 	return tags.FlattenAndSet(d, resp.Tags)
 }
 
@@ -176,3 +222,65 @@ func resourceServiceFabricMeshSecretValueDelete(d *pluginsdk.ResourceData, meta
 
 	return nil
 }
+
+// serviceFabricMeshSecretValueCreatePollInterval is how often a newly created Secret Value is polled until it can be retrieved
+var serviceFabricMeshSecretValueCreatePollInterval = 10 * time.Second
+
+// serviceFabricMeshSecretValueCreateClient is the subset of the Secret Value client used when creating a Secret Value
+type serviceFabricMeshSecretValueCreateClient interface {
+	Create(ctx context.Context, resourceGroupName string, secretResourceName string, secretValueResourceName string, secretValueResourceDescription servicefabricmesh.SecretValueResourceDescription) (servicefabricmesh.SecretValueResourceDescription, error)
+}
+
+func serviceFabricMeshSecretValueCreateRefreshFunc(ctx context.Context, client serviceFabricMeshSecretValueCreateClient, id parse.SecretValueId, parameters servicefabricmesh.SecretValueResourceDescription) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.Create(ctx, id.ResourceGroup, id.SecretName, id.ValueName, parameters)
+		if err != nil {
+			if utils.ResponseWasConflict(resp.Response) {
+				log.Printf("[DEBUG] the parent Secret of %s is locked - retrying", id)
+				return resp, "Conflict", nil
+			}
+
+			return nil, "Error", fmt.Errorf("issuing create request for %s: %+v", id, err)
+		}
+
+		return resp, "Created", nil
+	}
+}
+
+// serviceFabricMeshSecretValueGetClient is the subset of the Secret Value client used when waiting for a Secret Value to exist
+type serviceFabricMeshSecretValueGetClient interface {
+	Get(ctx context.Context, resourceGroupName string, secretResourceName string, secretValueResourceName string) (servicefabricmesh.SecretValueResourceDescription, error)
+}
+
+func waitForServiceFabricMeshSecretValueToExist(ctx context.Context, client serviceFabricMeshSecretValueGetClient, id parse.SecretValueId, pollInterval time.Duration) error {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("context had no deadline")
+	}
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending:      []string{"NotFound"},
+		Target:       []string{"Found"},
+		Refresh:      serviceFabricMeshSecretValueExistsRefreshFunc(ctx, client, id),
+		PollInterval: pollInterval,
+		Timeout:      time.Until(deadline),
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+func serviceFabricMeshSecretValueExistsRefreshFunc(ctx context.Context, client serviceFabricMeshSecretValueGetClient, id parse.SecretValueId) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.Get(ctx, id.ResourceGroup, id.SecretName, id.ValueName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				log.Printf("[DEBUG] %s was not found - retrying", id)
+				return resp, "NotFound", nil
+			}
+
+			return nil, "Error", fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+
+		return resp, "Found", nil
+	}
+}
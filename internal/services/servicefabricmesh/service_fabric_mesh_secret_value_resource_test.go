@@ -3,6 +3,7 @@ package servicefabricmesh_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
@@ -45,6 +46,20 @@ func TestAccServiceFabricMeshSecretValue_multiple(t *testing.T) {
 	})
 }
 
+func TestAccServiceFabricMeshSecretValue_valueIsSensitive(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_service_fabric_mesh_secret_value", "test")
+	r := ServiceFabricMeshSecretValueResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			// Terraform refuses to plan a non-sensitive output which references a sensitive value
+			Config:      r.valueAsOutput(data),
+			PlanOnly:    true,
+			ExpectError: regexp.MustCompile("Output refers to sensitive values"),
+		},
+	})
+}
+
 func (r ServiceFabricMeshSecretValueResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.SecretValueID(state.ID)
 	if err != nil {
@@ -120,3 +135,13 @@ resource "azurerm_service_fabric_mesh_secret_value" "test2" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger)
 }
+
+func (r ServiceFabricMeshSecretValueResource) valueAsOutput(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+output "secret_value" {
+  value = azurerm_service_fabric_mesh_secret_value.test.value
+}
+`, r.basic(data))
+}
@@ -576,7 +576,7 @@ func resourceArmSignalRServiceSchema() map[string]*pluginsdk.Schema {
 						Required: true,
 						Elem: &pluginsdk.Schema{
 							Type:         pluginsdk.TypeString,
-							ValidateFunc: validation.StringIsNotEmpty,
+							ValidateFunc: signalrValidate.UpstreamPattern,
 						},
 					},
 
@@ -585,7 +585,7 @@ func resourceArmSignalRServiceSchema() map[string]*pluginsdk.Schema {
 						Required: true,
 						Elem: &pluginsdk.Schema{
 							Type:         pluginsdk.TypeString,
-							ValidateFunc: validation.StringIsNotEmpty,
+							ValidateFunc: signalrValidate.UpstreamPattern,
 						},
 					},
 
@@ -594,7 +594,7 @@ func resourceArmSignalRServiceSchema() map[string]*pluginsdk.Schema {
 						Required: true,
 						Elem: &pluginsdk.Schema{
 							Type:         pluginsdk.TypeString,
-							ValidateFunc: validation.StringIsNotEmpty,
+							ValidateFunc: signalrValidate.UpstreamPattern,
 						},
 					},
 
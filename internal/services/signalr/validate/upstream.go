@@ -3,14 +3,87 @@ package validate
 import (
 	"fmt"
 	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var (
+	upstreamUrlRegex         = regexp.MustCompile(`^https?://[^\s]+$`)
+	upstreamPlaceholderRegex = regexp.MustCompile(`\{([^{}]*)\}`)
+	upstreamPatternRegex     = regexp.MustCompile(`^[^\s,]+(,[^\s,]+)*$`)
+	upstreamKeyVaultRegex    = regexp.MustCompile(`^@Microsoft\.KeyVault\(.+\)$`)
 )
 
 func UrlTemplate(v interface{}, k string) (warnings []string, errors []error) {
-	upstreamURL := v.(string)
+	return UrlTemplateWithPlaceholders("hub", "category", "event")(v, k)
+}
+
+// UrlTemplateWithPlaceholders validates a http(s) URL Template where any placeholders are wrapped in a matching
+// pair of braces and are either one of the specified placeholders or a Key Vault Secret reference
+// (e.g. `{@Microsoft.KeyVault(SecretUri=https://example.vault.azure.net/secrets/example)}`)
+func UrlTemplateWithPlaceholders(placeholders ...string) pluginsdk.SchemaValidateFunc {
+	supported := make([]string, 0)
+	for _, p := range placeholders {
+		supported = append(supported, fmt.Sprintf("`{%s}`", p))
+	}
+
+	return func(v interface{}, k string) (warnings []string, errors []error) {
+		urlTemplate, ok := v.(string)
+		if !ok {
+			errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+			return
+		}
+
+		if !upstreamUrlRegex.MatchString(urlTemplate) {
+			errors = append(errors, fmt.Errorf(
+				"%q must start with http:// or https:// and must not contain whitespaces: %q", k, urlTemplate))
+			return
+		}
+
+		for _, match := range upstreamPlaceholderRegex.FindAllStringSubmatch(urlTemplate, -1) {
+			if !isSupportedPlaceholder(match[1], placeholders) {
+				errors = append(errors, fmt.Errorf(
+					"%q contains the unsupported placeholder %q - supported placeholders are %s and Key Vault Secret references", k, match[0], strings.Join(supported, ", ")))
+			}
+		}
+
+		// strip out the placeholders, anything left over is an unbalanced placeholder
+		if strings.ContainsAny(upstreamPlaceholderRegex.ReplaceAllString(urlTemplate, ""), "{}") {
+			errors = append(errors, fmt.Errorf(
+				"%q contains an invalid placeholder - placeholders must be wrapped in a matching pair of braces: %q", k, urlTemplate))
+		}
+
+		return warnings, errors
+	}
+}
+
+func isSupportedPlaceholder(input string, placeholders []string) bool {
+	if upstreamKeyVaultRegex.MatchString(input) {
+		return true
+	}
+
+	for _, p := range placeholders {
+		if input == p {
+			return true
+		}
+	}
+
+	return false
+}
+
+// UpstreamPattern validates a single entry within a hub, event or category pattern, which is either `*`
+// (to match everything), a name or a comma-separated list of names
+func UpstreamPattern(v interface{}, k string) (warnings []string, errors []error) {
+	pattern, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
 
-	if !regexp.MustCompile(`^https?://[^\s]+$`).MatchString(upstreamURL) {
+	if !upstreamPatternRegex.MatchString(pattern) {
 		errors = append(errors, fmt.Errorf(
-			"%q must start with http:// or https:// and must not contain whitespaces: %q", k, upstreamURL))
+			"%q must be `*`, a name or a comma-separated list of names which don't contain whitespace, got %q", k, pattern))
 	}
 
 	return warnings, errors
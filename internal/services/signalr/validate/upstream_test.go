@@ -48,6 +48,18 @@ func TestUrlTemplate(t *testing.T) {
 			Input: "https://abc.com/api/test",
 			Valid: true,
 		},
+
+		{
+			// supported placeholders
+			Input: "http://host.com/{hub}/api/{category}/{event}",
+			Valid: true,
+		},
+
+		{
+			// unsupported placeholder
+			Input: "http://host.com/{hub}/api/{connection}",
+			Valid: false,
+		},
 	}
 	for _, tc := range cases {
 		t.Logf("[DEBUG] Testing Value %s", tc.Input)
@@ -60,3 +72,133 @@ func TestUrlTemplate(t *testing.T) {
 		}
 	}
 }
+
+func TestUrlTemplateWithPlaceholders(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			// empty
+			Input: "",
+			Valid: false,
+		},
+
+		{
+			// not a url
+			Input: "not a url",
+			Valid: false,
+		},
+
+		{
+			// no placeholders
+			Input: "https://test.com/api",
+			Valid: true,
+		},
+
+		{
+			// predefined placeholders
+			Input: "https://test.com/api/{hub}/{event}",
+			Valid: true,
+		},
+
+		{
+			// unclosed placeholder
+			Input: "https://test.com/api/{hub/{event}",
+			Valid: false,
+		},
+
+		{
+			// unopened placeholder
+			Input: "https://test.com/api/hub}/{event}",
+			Valid: false,
+		},
+
+		{
+			// empty placeholder
+			Input: "https://test.com/api/{}/{event}",
+			Valid: false,
+		},
+
+		{
+			// unsupported placeholder
+			Input: "https://test.com/api/{hub}/{category}",
+			Valid: false,
+		},
+
+		{
+			// key vault secret reference
+			Input: "https://test.com/api/{hub}/{event}?code={@Microsoft.KeyVault(SecretUri=https://example.vault.azure.net/secrets/example)}",
+			Valid: true,
+		},
+
+		{
+			// empty key vault secret reference
+			Input: "https://test.com/api/{hub}?code={@Microsoft.KeyVault()}",
+			Valid: false,
+		},
+	}
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %s", tc.Input)
+
+		_, errors := UrlTemplateWithPlaceholders("hub", "event")(tc.Input, "test")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t", tc.Valid, valid)
+		}
+	}
+}
+
+func TestUpstreamPattern(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			// empty
+			Input: "",
+			Valid: false,
+		},
+
+		{
+			// wildcard
+			Input: "*",
+			Valid: true,
+		},
+
+		{
+			// name
+			Input: "connections",
+			Valid: true,
+		},
+
+		{
+			// multiple names
+			Input: "connect,disconnect",
+			Valid: true,
+		},
+
+		{
+			// empty name
+			Input: "connect,,disconnect",
+			Valid: false,
+		},
+
+		{
+			// whitespace
+			Input: "hub 1",
+			Valid: false,
+		},
+	}
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %s", tc.Input)
+
+		_, errors := UpstreamPattern(tc.Input, "test")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t", tc.Valid, valid)
+		}
+	}
+}
@@ -60,7 +60,7 @@ func resourceWebPubsubHub() *pluginsdk.Resource {
 						"url_template": {
 							Type:         pluginsdk.TypeString,
 							Required:     true,
-							ValidateFunc: validation.StringIsNotEmpty,
+							ValidateFunc: validate.UrlTemplateWithPlaceholders("hub", "event"),
 						},
 
 						"user_event_pattern": {
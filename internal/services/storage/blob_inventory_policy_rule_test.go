@@ -0,0 +1,126 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func TestValidateBlobInventoryPolicyRule(t *testing.T) {
+	filter := func(blobTypes []interface{}, includeBlobVersions bool) []interface{} {
+		return []interface{}{
+			map[string]interface{}{
+				"blob_types":            pluginsdk.NewSet(pluginsdk.HashString, blobTypes),
+				"include_blob_versions": includeBlobVersions,
+				"include_snapshots":     false,
+				"prefix_match":          pluginsdk.NewSet(pluginsdk.HashString, []interface{}{}),
+			},
+		}
+	}
+
+	cases := []struct {
+		Name  string
+		Input map[string]interface{}
+		Valid bool
+	}{
+		{
+			Name: "blob scope",
+			Input: map[string]interface{}{
+				"scope":         "Blob",
+				"schema_fields": []interface{}{"Name", "Last-Modified"},
+				"filter":        filter([]interface{}{"blockBlob"}, true),
+			},
+			Valid: true,
+		},
+		{
+			Name: "blob scope without any schema fields",
+			Input: map[string]interface{}{
+				"scope":         "Blob",
+				"schema_fields": []interface{}{},
+				"filter":        filter([]interface{}{"blockBlob"}, false),
+			},
+			Valid: true,
+		},
+		{
+			Name: "blob scope with an unknown schema field",
+			Input: map[string]interface{}{
+				"scope":         "Blob",
+				"schema_fields": []interface{}{"Name", ""},
+				"filter":        filter([]interface{}{"blockBlob"}, false),
+			},
+			Valid: true,
+		},
+		{
+			Name: "blob scope without Name",
+			Input: map[string]interface{}{
+				"scope":         "Blob",
+				"schema_fields": []interface{}{"Last-Modified"},
+				"filter":        filter([]interface{}{"blockBlob"}, false),
+			},
+			Valid: false,
+		},
+		{
+			Name: "blob scope with a container schema field",
+			Input: map[string]interface{}{
+				"scope":         "Blob",
+				"schema_fields": []interface{}{"Name", "PublicAccess"},
+				"filter":        filter([]interface{}{"blockBlob"}, false),
+			},
+			Valid: false,
+		},
+		{
+			Name: "blob scope without blob types",
+			Input: map[string]interface{}{
+				"scope":         "Blob",
+				"schema_fields": []interface{}{"Name"},
+				"filter":        []interface{}{},
+			},
+			Valid: false,
+		},
+		{
+			Name: "container scope",
+			Input: map[string]interface{}{
+				"scope":         "Container",
+				"schema_fields": []interface{}{"Name", "PublicAccess"},
+				"filter":        []interface{}{},
+			},
+			Valid: true,
+		},
+		{
+			Name: "container scope with a blob schema field",
+			Input: map[string]interface{}{
+				"scope":         "Container",
+				"schema_fields": []interface{}{"Name", "BlobType"},
+				"filter":        []interface{}{},
+			},
+			Valid: false,
+		},
+		{
+			Name: "container scope with blob types",
+			Input: map[string]interface{}{
+				"scope":         "Container",
+				"schema_fields": []interface{}{"Name"},
+				"filter":        filter([]interface{}{"blockBlob"}, false),
+			},
+			Valid: false,
+		},
+		{
+			Name: "unknown scope",
+			Input: map[string]interface{}{
+				"scope":         "",
+				"schema_fields": []interface{}{"Anything"},
+				"filter":        []interface{}{},
+			},
+			Valid: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q", tc.Name)
+
+		err := validateBlobInventoryPolicyRule(tc.Input)
+		if valid := err == nil; valid != tc.Valid {
+			t.Fatalf("expected %t but got %t: %+v", tc.Valid, valid, err)
+		}
+	}
+}
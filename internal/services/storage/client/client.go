@@ -3,8 +3,8 @@ package client
 import (
 	"context"
 	"fmt"
+	"log"
 
-	legacystorage "github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-01-01/storage"
 	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-04-01/storage"
 	"github.com/Azure/azure-sdk-for-go/services/storagesync/mgmt/2020-03-01/storagesync"
 	"github.com/Azure/go-autorest/autorest"
@@ -30,17 +30,19 @@ type Client struct {
 	ADLSGen2PathsClient         *paths.Client
 	ManagementPoliciesClient    *storage.ManagementPoliciesClient
 	BlobServicesClient          *storage.BlobServicesClient
-	BlobInventoryPoliciesClient *legacystorage.BlobInventoryPoliciesClient
+	BlobInventoryPoliciesClient *storage.BlobInventoryPoliciesClient
 	CloudEndpointsClient        *storagesync.CloudEndpointsClient
 	EncryptionScopesClient      *storage.EncryptionScopesClient
 	Environment                 az.Environment
 	FileServicesClient          *storage.FileServicesClient
+	FileSharesResourceClient    *storage.FileSharesClient
 	ObjectReplicationClient     *storage.ObjectReplicationPoliciesClient
 	SyncServiceClient           *storagesync.ServicesClient
 	SyncGroupsClient            *storagesync.SyncGroupsClient
 	SubscriptionId              string
 
 	resourceManagerAuthorizer autorest.Authorizer
+	storageAuthorizer         autorest.Authorizer
 	storageAdAuth             *autorest.Authorizer
 }
 
@@ -60,7 +62,7 @@ func NewClient(options *common.ClientOptions) *Client {
 	blobServicesClient := storage.NewBlobServicesClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
 	options.ConfigureClient(&blobServicesClient.Client, options.ResourceManagerAuthorizer)
 
-	blobInventoryPoliciesClient := legacystorage.NewBlobInventoryPoliciesClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
+	blobInventoryPoliciesClient := storage.NewBlobInventoryPoliciesClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
 	options.ConfigureClient(&blobInventoryPoliciesClient.Client, options.ResourceManagerAuthorizer)
 
 	cloudEndpointsClient := storagesync.NewCloudEndpointsClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
@@ -72,6 +74,9 @@ func NewClient(options *common.ClientOptions) *Client {
 	fileServicesClient := storage.NewFileServicesClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
 	options.ConfigureClient(&fileServicesClient.Client, options.ResourceManagerAuthorizer)
 
+	fileSharesResourceClient := storage.NewFileSharesClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
+	options.ConfigureClient(&fileSharesResourceClient.Client, options.ResourceManagerAuthorizer)
+
 	objectReplicationPolicyClient := storage.NewObjectReplicationPoliciesClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
 	options.ConfigureClient(&objectReplicationPolicyClient.Client, options.ResourceManagerAuthorizer)
 
@@ -94,12 +99,14 @@ func NewClient(options *common.ClientOptions) *Client {
 		EncryptionScopesClient:      &encryptionScopesClient,
 		Environment:                 options.Environment,
 		FileServicesClient:          &fileServicesClient,
+		FileSharesResourceClient:    &fileSharesResourceClient,
 		ObjectReplicationClient:     &objectReplicationPolicyClient,
 		SubscriptionId:              options.SubscriptionId,
 		SyncServiceClient:           &syncServiceClient,
 		SyncGroupsClient:            &syncGroupsClient,
 
 		resourceManagerAuthorizer: options.ResourceManagerAuthorizer,
+		storageAuthorizer:         options.StorageAuthorizer,
 	}
 
 	if options.StorageUseAzureAD {
@@ -234,9 +241,9 @@ func (client Client) FileSharesClient(ctx context.Context, account accountDetail
 }
 
 func (client Client) QueuesClient(ctx context.Context, account accountDetails) (shim.StorageQueuesWrapper, error) {
-	if client.storageAdAuth != nil {
+	if storageAdAuth := client.azureADAuthorizerForAccount(account); storageAdAuth != nil {
 		queueClient := queues.NewWithEnvironment(client.Environment)
-		queueClient.Client.Authorizer = *client.storageAdAuth
+		queueClient.Client.Authorizer = *storageAdAuth
 		return shim.NewDataPlaneStorageQueueWrapper(&queueClient), nil
 	}
 
@@ -274,7 +281,14 @@ func (client Client) TableEntityClient(ctx context.Context, account accountDetai
 }
 
 func (client Client) TablesClient(ctx context.Context, account accountDetails) (shim.StorageTableWrapper, error) {
-	// NOTE: Tables do not support AzureAD Authentication
+	// NOTE: the Table ACL endpoints don't support AzureAD Authentication, as such we only use AzureAD
+	// when Shared Key access has been disabled on the Storage Account (and the Account Key can't be used)
+	if account.SharedKeyAccessDisabled() && client.storageAuthorizer != nil {
+		log.Printf("[DEBUG] Shared Key access is disabled for Storage Account %q - using Azure AD authentication", account.name)
+		tablesClient := tables.NewWithEnvironment(client.Environment)
+		tablesClient.Client.Authorizer = client.storageAuthorizer
+		return shim.NewDataPlaneStorageTableWrapper(&tablesClient), nil
+	}
 
 	accountKey, err := account.AccountKey(ctx, client)
 	if err != nil {
@@ -291,3 +305,19 @@ func (client Client) TablesClient(ctx context.Context, account accountDetails) (
 	shim := shim.NewDataPlaneStorageTableWrapper(&tablesClient)
 	return shim, nil
 }
+
+// azureADAuthorizerForAccount returns the Azure AD Authorizer which should be used for Data Plane operations against
+// this Storage Account - either when the Provider is configured to use Azure AD, or when Shared Key access has been
+// disabled on the Storage Account (meaning that the Account Key can't be used) - otherwise nil is returned.
+func (client Client) azureADAuthorizerForAccount(account accountDetails) *autorest.Authorizer {
+	if client.storageAdAuth != nil {
+		return client.storageAdAuth
+	}
+
+	if account.SharedKeyAccessDisabled() && client.storageAuthorizer != nil {
+		log.Printf("[DEBUG] Shared Key access is disabled for Storage Account %q - using Azure AD authentication", account.name)
+		return &client.storageAuthorizer
+	}
+
+	return nil
+}
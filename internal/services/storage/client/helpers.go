@@ -53,6 +53,16 @@ func (ad *accountDetails) AccountKey(ctx context.Context, client Client) (*strin
 	return ad.accountKey, nil
 }
 
+// SharedKeyAccessDisabled returns whether Shared Key access has been explicitly disabled on this Storage Account
+func (ad accountDetails) SharedKeyAccessDisabled() bool {
+	if ad.Properties == nil || ad.Properties.AllowSharedKeyAccess == nil {
+		// the API defaults this to `true` when it's not specified
+		return false
+	}
+
+	return !*ad.Properties.AllowSharedKeyAccess
+}
+
 func (client Client) AddToCache(accountName string, props storage.Account) error {
 	accountsLock.Lock()
 	defer accountsLock.Unlock()
@@ -631,6 +631,45 @@ func resourceStorageAccount() *pluginsdk.Resource {
 				},
 			},
 
+			"sas_policy": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"expiration_period": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validate.StorageAccountSASExpirationPeriod,
+						},
+
+						"expiration_action": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  "Log",
+							ValidateFunc: validation.StringInSlice([]string{
+								"Log",
+							}, false),
+						},
+					},
+				},
+			},
+
+			"key_policy": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"key_expiration_period_in_days": {
+							Type:         pluginsdk.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+
 			"share_properties": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -904,6 +943,206 @@ func resourceStorageAccount() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"primary_blob_internet_endpoint": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"primary_blob_internet_host": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"primary_blob_microsoft_endpoint": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"primary_blob_microsoft_host": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"primary_dfs_internet_endpoint": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"primary_dfs_internet_host": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"primary_dfs_microsoft_endpoint": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"primary_dfs_microsoft_host": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"primary_file_internet_endpoint": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"primary_file_internet_host": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"primary_file_microsoft_endpoint": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"primary_file_microsoft_host": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"primary_queue_microsoft_endpoint": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"primary_queue_microsoft_host": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"primary_table_microsoft_endpoint": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"primary_table_microsoft_host": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"primary_web_internet_endpoint": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"primary_web_internet_host": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"primary_web_microsoft_endpoint": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"primary_web_microsoft_host": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"secondary_blob_internet_endpoint": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"secondary_blob_internet_host": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"secondary_blob_microsoft_endpoint": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"secondary_blob_microsoft_host": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"secondary_dfs_internet_endpoint": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"secondary_dfs_internet_host": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"secondary_dfs_microsoft_endpoint": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"secondary_dfs_microsoft_host": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"secondary_file_internet_endpoint": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"secondary_file_internet_host": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"secondary_file_microsoft_endpoint": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"secondary_file_microsoft_host": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"secondary_queue_microsoft_endpoint": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"secondary_queue_microsoft_host": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"secondary_table_microsoft_endpoint": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"secondary_table_microsoft_host": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"secondary_web_internet_endpoint": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"secondary_web_internet_host": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"secondary_web_microsoft_endpoint": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"secondary_web_microsoft_host": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			"primary_access_key": {
 				Type:      pluginsdk.TypeString,
 				Sensitive: true,
@@ -962,6 +1201,19 @@ func resourceStorageAccount() *pluginsdk.Resource {
 					}
 				}
 
+				// the API doesn't support removing these policies once set, so the Storage Account needs to be recreated
+				if d.HasChange("sas_policy") {
+					if o, n := d.GetChange("sas_policy"); len(o.([]interface{})) > 0 && len(n.([]interface{})) == 0 {
+						d.ForceNew("sas_policy")
+					}
+				}
+
+				if d.HasChange("key_policy") {
+					if o, n := d.GetChange("key_policy"); len(o.([]interface{})) > 0 && len(n.([]interface{})) == 0 {
+						d.ForceNew("key_policy")
+					}
+				}
+
 				if d.HasChange("large_file_share_enabled") {
 					lfsEnabled, changedEnabled := d.GetChange("large_file_share_enabled")
 					if lfsEnabled.(bool) && !changedEnabled.(bool) {
@@ -1128,6 +1380,14 @@ func resourceStorageAccountCreate(d *pluginsdk.ResourceData, meta interface{}) e
 		parameters.RoutingPreference = expandArmStorageAccountRouting(v.([]interface{}))
 	}
 
+	if v, ok := d.GetOk("sas_policy"); ok {
+		parameters.SasPolicy = expandArmStorageAccountSASPolicy(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("key_policy"); ok {
+		parameters.KeyPolicy = expandArmStorageAccountKeyPolicy(v.([]interface{}))
+	}
+
 	// TODO 4.0
 	// look into standardizing this across resources that support CMK and at the very least look at improving the UX
 	// for encryption of blob, file, table and queue
@@ -1374,6 +1634,17 @@ func resourceStorageAccountUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		return fmt.Errorf("updating Azure Storage Account AllowSharedKeyAccess %q: %+v", id.Name, err)
 	}
 
+	if d.HasChange("shared_access_key_enabled") {
+		// refresh the cache, since the Data Plane clients use this to determine the authentication mechanism
+		account, err := client.GetProperties(ctx, id.ResourceGroup, id.Name, "")
+		if err != nil {
+			return fmt.Errorf("retrieving %s: %+v", *id, err)
+		}
+		if err := meta.(*clients.Client).Storage.AddToCache(id.Name, account); err != nil {
+			return fmt.Errorf("populating cache for %s: %+v", *id, err)
+		}
+	}
+
 	if d.HasChange("account_replication_type") {
 		sku := storage.Sku{
 			Name: storage.SkuName(storageType),
@@ -1571,6 +1842,30 @@ func resourceStorageAccountUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		}
 	}
 
+	if d.HasChange("sas_policy") {
+		opts := storage.AccountUpdateParameters{
+			AccountPropertiesUpdateParameters: &storage.AccountPropertiesUpdateParameters{
+				SasPolicy: expandArmStorageAccountSASPolicy(d.Get("sas_policy").([]interface{})),
+			},
+		}
+
+		if _, err := client.Update(ctx, id.ResourceGroup, id.Name, opts); err != nil {
+			return fmt.Errorf("updating Azure Storage Account sas_policy %q: %+v", id.Name, err)
+		}
+	}
+
+	if d.HasChange("key_policy") {
+		opts := storage.AccountUpdateParameters{
+			AccountPropertiesUpdateParameters: &storage.AccountPropertiesUpdateParameters{
+				KeyPolicy: expandArmStorageAccountKeyPolicy(d.Get("key_policy").([]interface{})),
+			},
+		}
+
+		if _, err := client.Update(ctx, id.ResourceGroup, id.Name, opts); err != nil {
+			return fmt.Errorf("updating Azure Storage Account key_policy %q: %+v", id.Name, err)
+		}
+	}
+
 	// azure_files_authentication must be the last to be updated, cause it'll occupy the storage account for several minutes after receiving the response 200 OK. Issue: https://github.com/Azure/azure-rest-api-specs/issues/11272
 	if d.HasChange("azure_files_authentication") {
 		// due to service issue: https://github.com/Azure/azure-rest-api-specs/issues/12473, we need to update to None before changing its DirectoryServiceOptions
@@ -1768,6 +2063,12 @@ func resourceStorageAccountRead(d *pluginsdk.ResourceData, meta interface{}) err
 		if err := d.Set("routing", flattenArmStorageAccountRouting(props.RoutingPreference)); err != nil {
 			return fmt.Errorf("setting `routing`: %+v", err)
 		}
+		if err := d.Set("sas_policy", flattenArmStorageAccountSASPolicy(props.SasPolicy)); err != nil {
+			return fmt.Errorf("setting `sas_policy`: %+v", err)
+		}
+		if err := d.Set("key_policy", flattenArmStorageAccountKeyPolicy(props.KeyPolicy)); err != nil {
+			return fmt.Errorf("setting `key_policy`: %+v", err)
+		}
 		d.Set("enable_https_traffic_only", props.EnableHTTPSTrafficOnly)
 		d.Set("is_hns_enabled", props.IsHnsEnabled)
 		d.Set("nfsv3_enabled", props.EnableNfsV3)
@@ -2271,6 +2572,29 @@ func expandArmStorageAccountRouting(input []interface{}) *storage.RoutingPrefere
 	}
 }
 
+func expandArmStorageAccountSASPolicy(input []interface{}) *storage.SasPolicy {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	return &storage.SasPolicy{
+		SasExpirationPeriod: utils.String(v["expiration_period"].(string)),
+		ExpirationAction:    utils.String(v["expiration_action"].(string)),
+	}
+}
+
+func expandArmStorageAccountKeyPolicy(input []interface{}) *storage.KeyPolicy {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	return &storage.KeyPolicy{
+		KeyExpirationPeriodInDays: utils.Int32(int32(v["key_expiration_period_in_days"].(int))),
+	}
+}
+
 func expandStorageAccountNetworkRules(d *pluginsdk.ResourceData, tenantId string) *storage.NetworkRuleSet {
 	networkRules := d.Get("network_rules").([]interface{})
 	if len(networkRules) == 0 {
@@ -2720,6 +3044,46 @@ func flattenArmStorageAccountRouting(input *storage.RoutingPreference) []interfa
 	}
 }
 
+func flattenArmStorageAccountSASPolicy(input *storage.SasPolicy) []interface{} {
+	if input == nil {
+		return make([]interface{}, 0)
+	}
+
+	var expirationPeriod string
+	if input.SasExpirationPeriod != nil {
+		expirationPeriod = *input.SasExpirationPeriod
+	}
+
+	var expirationAction string
+	if input.ExpirationAction != nil {
+		expirationAction = *input.ExpirationAction
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"expiration_period": expirationPeriod,
+			"expiration_action": expirationAction,
+		},
+	}
+}
+
+func flattenArmStorageAccountKeyPolicy(input *storage.KeyPolicy) []interface{} {
+	if input == nil {
+		return make([]interface{}, 0)
+	}
+
+	var keyExpirationPeriodInDays int
+	if input.KeyExpirationPeriodInDays != nil {
+		keyExpirationPeriodInDays = int(*input.KeyExpirationPeriodInDays)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"key_expiration_period_in_days": keyExpirationPeriodInDays,
+		},
+	}
+}
+
 func flattenStorageAccountNetworkRules(input *storage.NetworkRuleSet) []interface{} {
 	if input == nil {
 		return []interface{}{}
@@ -3242,7 +3606,7 @@ func flattenAndSetAzureRmStorageAccountPrimaryEndpoints(d *pluginsdk.ResourceDat
 		return err
 	}
 
-	return nil
+	return flattenAndSetAzureRmStorageAccountRoutingEndpoints(d, "primary", primary.MicrosoftEndpoints, primary.InternetEndpoints)
 }
 
 func flattenAndSetAzureRmStorageAccountSecondaryEndpoints(d *pluginsdk.ResourceData, secondary *storage.Endpoints) error {
@@ -3268,6 +3632,51 @@ func flattenAndSetAzureRmStorageAccountSecondaryEndpoints(d *pluginsdk.ResourceD
 	if err := setEndpointAndHost(d, "secondary", secondary.Web, "web"); err != nil {
 		return err
 	}
+
+	return flattenAndSetAzureRmStorageAccountRoutingEndpoints(d, "secondary", secondary.MicrosoftEndpoints, secondary.InternetEndpoints)
+}
+
+func flattenAndSetAzureRmStorageAccountRoutingEndpoints(d *pluginsdk.ResourceData, ordinalString string, microsoft *storage.AccountMicrosoftEndpoints, internet *storage.AccountInternetEndpoints) error {
+	// these are only returned when the corresponding endpoints are published via the `routing` block
+	if microsoft == nil {
+		microsoft = &storage.AccountMicrosoftEndpoints{}
+	}
+	if internet == nil {
+		internet = &storage.AccountInternetEndpoints{}
+	}
+
+	if err := setEndpointAndHost(d, ordinalString, microsoft.Blob, "blob_microsoft"); err != nil {
+		return err
+	}
+	if err := setEndpointAndHost(d, ordinalString, microsoft.Dfs, "dfs_microsoft"); err != nil {
+		return err
+	}
+	if err := setEndpointAndHost(d, ordinalString, microsoft.File, "file_microsoft"); err != nil {
+		return err
+	}
+	if err := setEndpointAndHost(d, ordinalString, microsoft.Queue, "queue_microsoft"); err != nil {
+		return err
+	}
+	if err := setEndpointAndHost(d, ordinalString, microsoft.Table, "table_microsoft"); err != nil {
+		return err
+	}
+	if err := setEndpointAndHost(d, ordinalString, microsoft.Web, "web_microsoft"); err != nil {
+		return err
+	}
+
+	if err := setEndpointAndHost(d, ordinalString, internet.Blob, "blob_internet"); err != nil {
+		return err
+	}
+	if err := setEndpointAndHost(d, ordinalString, internet.Dfs, "dfs_internet"); err != nil {
+		return err
+	}
+	if err := setEndpointAndHost(d, ordinalString, internet.File, "file_internet"); err != nil {
+		return err
+	}
+	if err := setEndpointAndHost(d, ordinalString, internet.Web, "web_internet"); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -922,6 +922,46 @@ func TestAccAzureRMStorageAccount_azureFilesAuthentication(t *testing.T) {
 	})
 }
 
+func TestAccStorageAccount_sasPolicy(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_account", "test")
+	r := StorageAccountResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.sasPolicy(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("sas_policy.0.expiration_action").HasValue("Log"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageAccount_keyPolicy(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_account", "test")
+	r := StorageAccountResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.keyPolicy(data, 90),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("key_policy.0.key_expiration_period_in_days").HasValue("90"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.keyPolicy(data, 30),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("key_policy.0.key_expiration_period_in_days").HasValue("30"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccAzureRMStorageAccount_routing(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_storage_account", "test")
 	r := StorageAccountResource{}
@@ -938,6 +978,9 @@ func TestAccAzureRMStorageAccount_routing(t *testing.T) {
 			Config: r.routing(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("primary_blob_microsoft_endpoint").Exists(),
+				check.That(data.ResourceName).Key("primary_blob_microsoft_host").Exists(),
+				check.That(data.ResourceName).Key("primary_blob_internet_endpoint").HasValue(""),
 			),
 		},
 		data.ImportStep(),
@@ -945,6 +988,9 @@ func TestAccAzureRMStorageAccount_routing(t *testing.T) {
 			Config: r.routingUpdate(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("primary_blob_internet_endpoint").Exists(),
+				check.That(data.ResourceName).Key("primary_blob_internet_host").Exists(),
+				check.That(data.ResourceName).Key("primary_blob_microsoft_endpoint").HasValue(""),
 			),
 		},
 		data.ImportStep(),
@@ -3002,6 +3048,56 @@ resource "azurerm_storage_account" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomString)
 }
 
+func (r StorageAccountResource) sasPolicy(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-storage-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "unlikely23exst2acct%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+
+  sas_policy {
+    expiration_period = "1.15:30:00"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
+
+func (r StorageAccountResource) keyPolicy(data acceptance.TestData, expirationPeriodInDays int) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-storage-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "unlikely23exst2acct%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+
+  key_policy {
+    key_expiration_period_in_days = %d
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, expirationPeriodInDays)
+}
+
 func (r StorageAccountResource) shareProperties(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -1,13 +1,17 @@
 package storage
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-01-01/storage"
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-04-01/storage"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -35,79 +39,224 @@ func resourceStorageBlobInventoryPolicy() *pluginsdk.Resource {
 			return err
 		}),
 
-		Schema: map[string]*pluginsdk.Schema{
-			"storage_account_id": {
-				Type:         pluginsdk.TypeString,
-				Required:     true,
-				ForceNew:     true,
-				ValidateFunc: validate.StorageAccountID,
-			},
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceStorageBlobInventoryPolicyCustomizeDiff),
+
+		Schema: func() map[string]*pluginsdk.Schema {
+			out := map[string]*pluginsdk.Schema{
+				"storage_account_id": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validate.StorageAccountID,
+				},
+
+				"rules": {
+					Type:     pluginsdk.TypeSet,
+					Required: true,
+					// rules are identified by their name, since some of the fields within are Computed
+					Set: resourceStorageBlobInventoryPolicyRuleHash,
+					Elem: &pluginsdk.Resource{
+						Schema: resourceStorageBlobInventoryPolicyRuleSchema(),
+					},
+				},
+			}
+
+			// TODO: remove in 3.0 - the destination container is now configured per rule
+			if !features.ThreePointOh() {
+				out["storage_container_name"] = &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validate.StorageContainerName,
+					Deprecated:   "`storage_container_name` has been deprecated in favour of the `storage_container_name` field within the `rules` block and will be removed in version 3.0 of the AzureRM Provider",
+				}
+			}
+
+			return out
+		}(),
+	}
+}
+
+func resourceStorageBlobInventoryPolicyRuleSchema() map[string]*pluginsdk.Schema {
+	out := map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
 
-			"storage_container_name": {
+		"storage_container_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validate.StorageContainerName,
+		},
+
+		"format": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(storage.FormatCsv),
+				string(storage.FormatParquet),
+			}, false),
+		},
+
+		"schedule": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(storage.ScheduleDaily),
+				string(storage.ScheduleWeekly),
+			}, false),
+		},
+
+		"scope": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(storage.ObjectTypeBlob),
+				string(storage.ObjectTypeContainer),
+			}, false),
+		},
+
+		"schema_fields": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			Elem: &pluginsdk.Schema{
 				Type:         pluginsdk.TypeString,
-				Required:     true,
-				ForceNew:     true,
-				ValidateFunc: validate.StorageContainerName,
+				ValidateFunc: validation.StringIsNotEmpty,
 			},
+		},
 
-			"rules": {
-				Type:     pluginsdk.TypeSet,
-				Required: true,
-				Elem: &pluginsdk.Resource{
-					Schema: map[string]*pluginsdk.Schema{
-						"name": {
-							Type:         pluginsdk.TypeString,
-							Required:     true,
-							ValidateFunc: validation.StringIsNotEmpty,
+		"filter": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"blob_types": {
+						Type:     pluginsdk.TypeSet,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+							ValidateFunc: validation.StringInSlice([]string{
+								"blockBlob",
+								"appendBlob",
+								"pageBlob",
+							}, false),
 						},
+					},
 
-						"filter": {
-							Type:     pluginsdk.TypeList,
-							Required: true,
-							MaxItems: 1,
-							Elem: &pluginsdk.Resource{
-								Schema: map[string]*pluginsdk.Schema{
-									"blob_types": {
-										Type:     pluginsdk.TypeSet,
-										Required: true,
-										Elem: &pluginsdk.Schema{
-											Type: pluginsdk.TypeString,
-											ValidateFunc: validation.StringInSlice([]string{
-												"blockBlob",
-												"appendBlob",
-												"pageBlob",
-											}, false),
-										},
-									},
-
-									"include_blob_versions": {
-										Type:     pluginsdk.TypeBool,
-										Optional: true,
-										Default:  false,
-									},
-
-									"include_snapshots": {
-										Type:     pluginsdk.TypeBool,
-										Optional: true,
-										Default:  false,
-									},
-
-									"prefix_match": {
-										Type:     pluginsdk.TypeSet,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type:         pluginsdk.TypeString,
-											ValidateFunc: validation.StringIsNotEmpty,
-										},
-									},
-								},
-							},
+					"include_blob_versions": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+
+					"include_snapshots": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+
+					"prefix_match": {
+						Type:     pluginsdk.TypeSet,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
 						},
 					},
 				},
 			},
 		},
 	}
+
+	// TODO: remove in 3.0 - prior to the 2021-04-01 API these weren't configurable, so default to the values used previously
+	if !features.ThreePointOh() {
+		out["storage_container_name"].Required = false
+		out["storage_container_name"].Optional = true
+		out["storage_container_name"].Computed = true
+
+		out["format"].Required = false
+		out["format"].Optional = true
+		out["format"].Default = string(storage.FormatCsv)
+
+		out["schedule"].Required = false
+		out["schedule"].Optional = true
+		out["schedule"].Default = string(storage.ScheduleDaily)
+
+		out["scope"].Required = false
+		out["scope"].Optional = true
+		out["scope"].Default = string(storage.ObjectTypeBlob)
+
+		out["schema_fields"].Required = false
+		out["schema_fields"].Optional = true
+		out["schema_fields"].Computed = true
+	}
+
+	return out
+}
+
+func resourceStorageBlobInventoryPolicyRuleHash(v interface{}) int {
+	var buf bytes.Buffer
+
+	if m, ok := v.(map[string]interface{}); ok {
+		buf.WriteString(fmt.Sprintf("%s-", m["name"].(string)))
+	}
+
+	return pluginsdk.HashString(buf.String())
+}
+
+func resourceStorageBlobInventoryPolicyCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+	for _, item := range diff.Get("rules").(*pluginsdk.Set).List() {
+		rule := item.(map[string]interface{})
+		if err := validateBlobInventoryPolicyRule(rule); err != nil {
+			return fmt.Errorf("rule %q: %+v", rule["name"].(string), err)
+		}
+	}
+
+	return nil
+}
+
+// validateBlobInventoryPolicyRule checks the schema fields and filter of a rule are valid for its scope
+func validateBlobInventoryPolicyRule(rule map[string]interface{}) error {
+	scope := rule["scope"].(string)
+	if scope == "" {
+		// the scope isn't known until apply time
+		return nil
+	}
+
+	schemaFields := make([]string, 0)
+	for _, field := range rule["schema_fields"].([]interface{}) {
+		// unknown values are returned as an empty string
+		if v, ok := field.(string); ok && v != "" {
+			schemaFields = append(schemaFields, v)
+		}
+	}
+	if len(schemaFields) > 0 {
+		if err := validateBlobInventoryPolicySchemaFields(scope, schemaFields); err != nil {
+			return err
+		}
+	}
+
+	blobTypes := 0
+	includeBlobVersions := false
+	includeSnapshots := false
+	if filters := rule["filter"].([]interface{}); len(filters) > 0 && filters[0] != nil {
+		filter := filters[0].(map[string]interface{})
+		blobTypes = filter["blob_types"].(*pluginsdk.Set).Len()
+		includeBlobVersions = filter["include_blob_versions"].(bool)
+		includeSnapshots = filter["include_snapshots"].(bool)
+	}
+
+	if scope == string(storage.ObjectTypeBlob) && blobTypes == 0 {
+		return fmt.Errorf("a `filter` block with `blob_types` must be specified when `scope` is `%s`", string(storage.ObjectTypeBlob))
+	}
+	if scope == string(storage.ObjectTypeContainer) && (blobTypes > 0 || includeBlobVersions || includeSnapshots) {
+		return fmt.Errorf("only `prefix_match` can be specified within the `filter` block when `scope` is `%s`", string(storage.ObjectTypeContainer))
+	}
+
+	return nil
 }
 
 func resourceStorageBlobInventoryPolicyCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -135,13 +284,23 @@ func resourceStorageBlobInventoryPolicyCreateUpdate(d *pluginsdk.ResourceData, m
 		}
 	}
 
+	// TODO: remove in 3.0
+	defaultContainerName := ""
+	if !features.ThreePointOh() {
+		defaultContainerName = d.Get("storage_container_name").(string)
+	}
+
+	rules, err := expandBlobInventoryPolicyRules(d.Get("rules").(*pluginsdk.Set).List(), defaultContainerName)
+	if err != nil {
+		return fmt.Errorf("expanding `rules`: %+v", err)
+	}
+
 	props := storage.BlobInventoryPolicy{
 		BlobInventoryPolicyProperties: &storage.BlobInventoryPolicyProperties{
 			Policy: &storage.BlobInventoryPolicySchema{
-				Enabled:     utils.Bool(true),
-				Destination: utils.String(d.Get("storage_container_name").(string)),
-				Type:        utils.String("Inventory"),
-				Rules:       expandBlobInventoryPolicyRules(d.Get("rules").(*pluginsdk.Set).List()),
+				Enabled: utils.Bool(true),
+				Type:    utils.String("Inventory"),
+				Rules:   rules,
 			},
 		},
 	}
@@ -181,8 +340,14 @@ func resourceStorageBlobInventoryPolicyRead(d *pluginsdk.ResourceData, meta inte
 				d.SetId("")
 				return nil
 			}
-			d.Set("storage_container_name", policy.Destination)
-			d.Set("rules", flattenBlobInventoryPolicyRules(policy.Rules))
+			if err := d.Set("rules", flattenBlobInventoryPolicyRules(policy.Rules)); err != nil {
+				return fmt.Errorf("setting `rules`: %+v", err)
+			}
+
+			// TODO: remove in 3.0
+			if !features.ThreePointOh() {
+				d.Set("storage_container_name", flattenBlobInventoryPolicyCommonDestination(policy.Rules))
+			}
 		}
 	}
 	return nil
@@ -204,19 +369,128 @@ func resourceStorageBlobInventoryPolicyDelete(d *pluginsdk.ResourceData, meta in
 	return nil
 }
 
-func expandBlobInventoryPolicyRules(input []interface{}) *[]storage.BlobInventoryPolicyRule {
+func expandBlobInventoryPolicyRules(input []interface{}, defaultContainerName string) (*[]storage.BlobInventoryPolicyRule, error) {
 	results := make([]storage.BlobInventoryPolicyRule, 0)
 	for _, item := range input {
 		v := item.(map[string]interface{})
+		name := v["name"].(string)
+		scope := v["scope"].(string)
+
+		containerName := v["storage_container_name"].(string)
+		if containerName == "" {
+			containerName = defaultContainerName
+		}
+		if containerName == "" {
+			return nil, fmt.Errorf("rule %q: `storage_container_name` must be specified", name)
+		}
+
+		schemaFields := utils.ExpandStringSlice(v["schema_fields"].([]interface{}))
+		if len(*schemaFields) == 0 {
+			schemaFields = blobInventoryPolicyDefaultSchemaFields(scope)
+		}
+
+		filter := expandBlobInventoryPolicyFilter(v["filter"].([]interface{}))
+		if scope == string(storage.ObjectTypeContainer) && filter != nil {
+			filter.BlobTypes = nil
+			filter.IncludeBlobVersions = nil
+			filter.IncludeSnapshots = nil
+		}
+
 		results = append(results, storage.BlobInventoryPolicyRule{
-			Enabled: utils.Bool(true),
-			Name:    utils.String(v["name"].(string)),
+			Enabled:     utils.Bool(true),
+			Name:        utils.String(name),
+			Destination: utils.String(containerName),
 			Definition: &storage.BlobInventoryPolicyDefinition{
-				Filters: expandBlobInventoryPolicyFilter(v["filter"].([]interface{})),
+				Format:       storage.Format(v["format"].(string)),
+				Schedule:     storage.Schedule(v["schedule"].(string)),
+				ObjectType:   storage.ObjectType(scope),
+				SchemaFields: schemaFields,
+				Filters:      filter,
 			},
 		})
 	}
-	return &results
+	return &results, nil
+}
+
+// blobInventoryPolicyDefaultSchemaFields returns the fields which were implicitly included prior to `schema_fields` being configurable
+func blobInventoryPolicyDefaultSchemaFields(scope string) *[]string {
+	if scope == string(storage.ObjectTypeContainer) {
+		return &[]string{
+			"Name",
+			"Last-Modified",
+			"Metadata",
+		}
+	}
+
+	return &[]string{
+		"Name",
+		"Creation-Time",
+		"Last-Modified",
+		"Content-Length",
+		"Content-MD5",
+		"BlobType",
+		"AccessTier",
+		"AccessTierChangeTime",
+	}
+}
+
+// validateBlobInventoryPolicySchemaFields checks the schema fields against the set the API allows for the given scope
+func validateBlobInventoryPolicySchemaFields(scope string, fields []string) error {
+	allowed := blobInventoryPolicyBlobSchemaFields
+	if scope == string(storage.ObjectTypeContainer) {
+		allowed = blobInventoryPolicyContainerSchemaFields
+	}
+
+	hasName := false
+	for _, field := range fields {
+		if field == "Name" {
+			hasName = true
+		}
+
+		if !utils.SliceContainsValue(allowed, field) {
+			return fmt.Errorf("%q is not a valid schema field when `scope` is %q - possible values are: %s", field, scope, strings.Join(allowed, ", "))
+		}
+	}
+
+	if !hasName {
+		return fmt.Errorf("`schema_fields` must contain `Name`")
+	}
+
+	return nil
+}
+
+var blobInventoryPolicyBlobSchemaFields = []string{
+	"Name",
+	"Creation-Time",
+	"Last-Modified",
+	"Content-Length",
+	"Content-MD5",
+	"BlobType",
+	"AccessTier",
+	"AccessTierChangeTime",
+	"Expiry-Time",
+	"hdi_isfolder",
+	"Owner",
+	"Group",
+	"Permissions",
+	"Acl",
+	"Snapshot",
+	"VersionId",
+	"IsCurrentVersion",
+	"Metadata",
+	"LastAccessTime",
+}
+
+var blobInventoryPolicyContainerSchemaFields = []string{
+	"Name",
+	"Last-Modified",
+	"Metadata",
+	"LeaseStatus",
+	"LeaseState",
+	"LeaseDuration",
+	"PublicAccess",
+	"HasImmutabilityPolicy",
+	"HasLegalHold",
 }
 
 func expandBlobInventoryPolicyFilter(input []interface{}) *storage.BlobInventoryPolicyFilter {
@@ -243,17 +517,46 @@ func flattenBlobInventoryPolicyRules(input *[]storage.BlobInventoryPolicyRule) [
 		if item.Name != nil {
 			name = *item.Name
 		}
+		var destination string
+		if item.Destination != nil {
+			destination = *item.Destination
+		}
 		if item.Enabled == nil || !*item.Enabled || item.Definition == nil {
 			continue
 		}
 		results = append(results, map[string]interface{}{
-			"name":   name,
-			"filter": flattenBlobInventoryPolicyFilter(item.Definition.Filters),
+			"name":                   name,
+			"storage_container_name": destination,
+			"format":                 string(item.Definition.Format),
+			"schedule":               string(item.Definition.Schedule),
+			"scope":                  string(item.Definition.ObjectType),
+			"schema_fields":          utils.FlattenStringSlice(item.Definition.SchemaFields),
+			"filter":                 flattenBlobInventoryPolicyFilter(item.Definition.Filters),
 		})
 	}
 	return results
 }
 
+// flattenBlobInventoryPolicyCommonDestination returns the destination container when this is the same for all rules
+func flattenBlobInventoryPolicyCommonDestination(input *[]storage.BlobInventoryPolicyRule) string {
+	if input == nil {
+		return ""
+	}
+
+	destination := ""
+	for _, item := range *input {
+		if item.Destination == nil {
+			continue
+		}
+
+		if destination != "" && destination != *item.Destination {
+			return ""
+		}
+		destination = *item.Destination
+	}
+	return destination
+}
+
 func flattenBlobInventoryPolicyFilter(input *storage.BlobInventoryPolicyFilter) []interface{} {
 	if input == nil {
 		return make([]interface{}, 0)
@@ -29,6 +29,20 @@ func TestAccStorageBlobInventoryPolicy_basic(t *testing.T) {
 	})
 }
 
+func TestAccStorageBlobInventoryPolicy_legacyContainerName(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_blob_inventory_policy", "test")
+	r := StorageBlobInventoryPolicyResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.legacyContainerName(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("storage_container_name"),
+	})
+}
+
 func TestAccStorageBlobInventoryPolicy_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_storage_blob_inventory_policy", "test")
 	r := StorageBlobInventoryPolicyResource{}
@@ -57,6 +71,20 @@ func TestAccStorageBlobInventoryPolicy_complete(t *testing.T) {
 	})
 }
 
+func TestAccStorageBlobInventoryPolicy_containerScope(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_blob_inventory_policy", "test")
+	r := StorageBlobInventoryPolicyResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.containerScope(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccStorageBlobInventoryPolicy_update(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_storage_blob_inventory_policy", "test")
 	r := StorageBlobInventoryPolicyResource{}
@@ -149,6 +177,27 @@ func (r StorageBlobInventoryPolicyResource) basic(data acceptance.TestData) stri
 	return fmt.Sprintf(`
 %s
 
+resource "azurerm_storage_blob_inventory_policy" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+  rules {
+    name                   = "rule1"
+    storage_container_name = azurerm_storage_container.test.name
+    format                 = "Csv"
+    schedule               = "Daily"
+    scope                  = "Blob"
+    schema_fields          = ["Name", "Last-Modified"]
+    filter {
+      blob_types = ["blockBlob"]
+    }
+  }
+}
+`, r.template(data))
+}
+
+func (r StorageBlobInventoryPolicyResource) legacyContainerName(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
 resource "azurerm_storage_blob_inventory_policy" "test" {
   storage_account_id     = azurerm_storage_account.test.id
   storage_container_name = azurerm_storage_container.test.name
@@ -167,13 +216,16 @@ func (r StorageBlobInventoryPolicyResource) requiresImport(data acceptance.TestD
 %s
 
 resource "azurerm_storage_blob_inventory_policy" "import" {
-  storage_account_id     = azurerm_storage_blob_inventory_policy.test.storage_account_id
-  storage_container_name = azurerm_storage_blob_inventory_policy.test.storage_container_name
+  storage_account_id = azurerm_storage_blob_inventory_policy.test.storage_account_id
   rules {
-    name = tolist(azurerm_storage_blob_inventory_policy.test.rules).0.name
+    name                   = tolist(azurerm_storage_blob_inventory_policy.test.rules).0.name
+    storage_container_name = tolist(azurerm_storage_blob_inventory_policy.test.rules).0.storage_container_name
+    format                 = tolist(azurerm_storage_blob_inventory_policy.test.rules).0.format
+    schedule               = tolist(azurerm_storage_blob_inventory_policy.test.rules).0.schedule
+    scope                  = tolist(azurerm_storage_blob_inventory_policy.test.rules).0.scope
+    schema_fields          = tolist(azurerm_storage_blob_inventory_policy.test.rules).0.schema_fields
     filter {
       blob_types = tolist(azurerm_storage_blob_inventory_policy.test.rules).0.filter.0.blob_types
-
     }
   }
 }
@@ -186,10 +238,14 @@ func (r StorageBlobInventoryPolicyResource) complete(data acceptance.TestData) s
 %s
 
 resource "azurerm_storage_blob_inventory_policy" "test" {
-  storage_account_id     = azurerm_storage_account.test.id
-  storage_container_name = azurerm_storage_container.test.name
+  storage_account_id = azurerm_storage_account.test.id
   rules {
-    name = "rule1"
+    name                   = "rule1"
+    storage_container_name = azurerm_storage_container.test.name
+    format                 = "Parquet"
+    schedule               = "Weekly"
+    scope                  = "Blob"
+    schema_fields          = ["Name", "Creation-Time", "VersionId", "IsCurrentVersion", "Snapshot", "Metadata"]
     filter {
       blob_types            = ["blockBlob", "pageBlob"]
       include_blob_versions = true
@@ -207,10 +263,14 @@ func (r StorageBlobInventoryPolicyResource) multipleRules(data acceptance.TestDa
 %s
 
 resource "azurerm_storage_blob_inventory_policy" "test" {
-  storage_account_id     = azurerm_storage_account.test.id
-  storage_container_name = azurerm_storage_container.test.name
+  storage_account_id = azurerm_storage_account.test.id
   rules {
-    name = "rule1"
+    name                   = "rule1"
+    storage_container_name = azurerm_storage_container.test.name
+    format                 = "Parquet"
+    schedule               = "Weekly"
+    scope                  = "Blob"
+    schema_fields          = ["Name", "Creation-Time", "VersionId", "IsCurrentVersion", "Snapshot", "Metadata"]
     filter {
       blob_types            = ["blockBlob", "pageBlob"]
       include_blob_versions = true
@@ -220,7 +280,12 @@ resource "azurerm_storage_blob_inventory_policy" "test" {
   }
 
   rules {
-    name = "rule2"
+    name                   = "rule2"
+    storage_container_name = azurerm_storage_container.test.name
+    format                 = "Csv"
+    schedule               = "Daily"
+    scope                  = "Blob"
+    schema_fields          = ["Name", "Content-Length", "BlobType"]
     filter {
       blob_types            = ["appendBlob"]
       include_blob_versions = false
@@ -231,3 +296,24 @@ resource "azurerm_storage_blob_inventory_policy" "test" {
 }
 `, template)
 }
+
+func (r StorageBlobInventoryPolicyResource) containerScope(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_blob_inventory_policy" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+  rules {
+    name                   = "rule1"
+    storage_container_name = azurerm_storage_container.test.name
+    format                 = "Csv"
+    schedule               = "Weekly"
+    scope                  = "Container"
+    schema_fields          = ["Name", "Last-Modified", "PublicAccess", "HasLegalHold"]
+    filter {
+      prefix_match = ["*/test"]
+    }
+  }
+}
+`, r.template(data))
+}
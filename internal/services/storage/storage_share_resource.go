@@ -1,10 +1,12 @@
 package storage
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-04-01/storage"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/migration"
@@ -30,6 +32,8 @@ func resourceStorageShare() *pluginsdk.Resource {
 			1: migration.ShareV1ToV2{},
 		}),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceStorageShareCustomizeDiff),
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
@@ -108,6 +112,18 @@ func resourceStorageShare() *pluginsdk.Resource {
 				Default: string(shares.SMB),
 			},
 
+			"access_tier": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(storage.ShareAccessTierCool),
+					string(storage.ShareAccessTierHot),
+					string(storage.ShareAccessTierPremium),
+					string(storage.ShareAccessTierTransactionOptimized),
+				}, false),
+			},
+
 			"resource_manager_id": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -175,6 +191,12 @@ func resourceStorageShareCreate(d *pluginsdk.ResourceData, meta interface{}) err
 		return fmt.Errorf("setting ACL's for Share %q (Account %q / Resource Group %q): %+v", shareName, accountName, account.ResourceGroup, err)
 	}
 
+	if accessTier := d.Get("access_tier").(string); accessTier != "" {
+		if err := updateStorageShareAccessTier(ctx, storageClient.FileSharesResourceClient, account.ResourceGroup, accountName, shareName, accessTier); err != nil {
+			return fmt.Errorf("setting Access Tier for Share %q (Account %q / Resource Group %q): %+v", shareName, accountName, account.ResourceGroup, err)
+		}
+	}
+
 	return resourceStorageShareRead(d, meta)
 }
 
@@ -219,6 +241,17 @@ func resourceStorageShareRead(d *pluginsdk.ResourceData, meta interface{}) error
 	d.Set("url", id.ID())
 	d.Set("enabled_protocol", string(props.EnabledProtocol))
 
+	// the Access Tier isn't exposed by the Data Plane API, so we need to look this up via Resource Manager
+	share, err := storageClient.FileSharesResourceClient.Get(ctx, account.ResourceGroup, id.AccountName, id.Name, "", "")
+	if err != nil {
+		return fmt.Errorf("retrieving Access Tier for File Share %q (Account %q / Resource Group %q): %+v", id.Name, id.AccountName, account.ResourceGroup, err)
+	}
+	accessTier := ""
+	if props := share.FileShareProperties; props != nil {
+		accessTier = string(props.AccessTier)
+	}
+	d.Set("access_tier", accessTier)
+
 	if err := d.Set("acl", flattenStorageShareACLs(props.ACLs)); err != nil {
 		return fmt.Errorf("flattening `acl`: %+v", err)
 	}
@@ -293,6 +326,17 @@ func resourceStorageShareUpdate(d *pluginsdk.ResourceData, meta interface{}) err
 		log.Printf("[DEBUG] Updated the ACL's for File Share %q (Storage Account %q)", id.Name, id.AccountName)
 	}
 
+	if d.HasChange("access_tier") {
+		log.Printf("[DEBUG] Updating the Access Tier for File Share %q (Storage Account %q)", id.Name, id.AccountName)
+
+		accessTier := d.Get("access_tier").(string)
+		if err := updateStorageShareAccessTier(ctx, storageClient.FileSharesResourceClient, account.ResourceGroup, id.AccountName, id.Name, accessTier); err != nil {
+			return fmt.Errorf("updating Access Tier for File Share %q (Storage Account %q): %s", id.Name, id.AccountName, err)
+		}
+
+		log.Printf("[DEBUG] Updated the Access Tier for File Share %q (Storage Account %q)", id.Name, id.AccountName)
+	}
+
 	return resourceStorageShareRead(d, meta)
 }
 
@@ -326,6 +370,81 @@ func resourceStorageShareDelete(d *pluginsdk.ResourceData, meta interface{}) err
 	return nil
 }
 
+func resourceStorageShareCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+	// `enabled_protocol` is ForceNew, so the Storage Account only needs checking when creating or changing the `access_tier`
+	if diff.Id() != "" && !diff.HasChange("access_tier") {
+		return nil
+	}
+
+	protocol := diff.Get("enabled_protocol").(string)
+	accessTier := diff.Get("access_tier").(string)
+	if protocol != string(shares.NFS) && accessTier == "" {
+		return nil
+	}
+
+	// the Storage Account name may not be known yet (e.g. when it's being created in the same plan)
+	accountName := diff.Get("storage_account_name").(string)
+	if accountName == "" {
+		return nil
+	}
+
+	storageClient := v.(*clients.Client).Storage
+	account, err := storageClient.FindAccount(ctx, accountName)
+	if err != nil {
+		return fmt.Errorf("retrieving Account %q: %s", accountName, err)
+	}
+	if account == nil {
+		// the Storage Account doesn't exist yet, so this'll be validated when it does
+		return nil
+	}
+
+	return validateStorageShareAccount(ctx, storageClient.AccountsClient, account.ResourceGroup, accountName, protocol, accessTier)
+}
+
+// validateStorageShareAccount ensures the Storage Account hosting the Share supports the selected protocol and access tier,
+// since otherwise the API returns an unhelpful error once the Share is being created/updated
+func validateStorageShareAccount(ctx context.Context, client *storage.AccountsClient, resourceGroup, accountName, protocol, accessTier string) error {
+	if protocol != string(shares.NFS) && accessTier == "" {
+		return nil
+	}
+
+	resp, err := client.GetProperties(ctx, resourceGroup, accountName, "")
+	if err != nil {
+		return fmt.Errorf("retrieving Storage Account %q (Resource Group %q): %+v", accountName, resourceGroup, err)
+	}
+
+	isPremiumFileStorage := resp.Kind == storage.KindFileStorage && resp.Sku != nil && resp.Sku.Tier == storage.SkuTierPremium
+
+	if protocol == string(shares.NFS) && !isPremiumFileStorage {
+		return fmt.Errorf("`enabled_protocol` can only be set to `NFS` when the Storage Account has an `account_kind` of `FileStorage` and an `account_tier` of `Premium`")
+	}
+
+	if accessTier == "" {
+		return nil
+	}
+	if isPremiumFileStorage && accessTier != string(storage.ShareAccessTierPremium) {
+		return fmt.Errorf("`access_tier` must be set to `Premium` when the Storage Account has an `account_kind` of `FileStorage` and an `account_tier` of `Premium`")
+	}
+	if !isPremiumFileStorage && accessTier == string(storage.ShareAccessTierPremium) {
+		return fmt.Errorf("`access_tier` can only be set to `Premium` when the Storage Account has an `account_kind` of `FileStorage` and an `account_tier` of `Premium`")
+	}
+
+	return nil
+}
+
+func updateStorageShareAccessTier(ctx context.Context, client *storage.FileSharesClient, resourceGroup, accountName, shareName, accessTier string) error {
+	input := storage.FileShare{
+		FileShareProperties: &storage.FileShareProperties{
+			AccessTier: storage.ShareAccessTier(accessTier),
+		},
+	}
+	if _, err := client.Update(ctx, resourceGroup, accountName, shareName, input); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func expandStorageShareACLs(input []interface{}) []shares.SignedIdentifier {
 	results := make([]shares.SignedIdentifier, 0)
 
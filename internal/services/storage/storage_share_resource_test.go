@@ -3,6 +3,7 @@ package storage_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
@@ -223,6 +224,58 @@ func TestAccStorageShare_protocolUpdate(t *testing.T) {
 	})
 }
 
+func TestAccStorageShare_nfsProtocolRequiresPremiumFileStorage(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_share", "test")
+	r := StorageShareResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.nfsProtocolStandardAccount(data),
+			ExpectError: regexp.MustCompile("`enabled_protocol` can only be set to `NFS` when the Storage Account has an `account_kind` of `FileStorage` and an `account_tier` of `Premium`"),
+		},
+	})
+}
+
+func TestAccStorageShare_accessTierStandard(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_share", "test")
+	r := StorageShareResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.accessTierStandard(data, "Cool"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("access_tier").HasValue("Cool"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.accessTierStandard(data, "Hot"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("access_tier").HasValue("Hot"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageShare_accessTierPremium(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_share", "test")
+	r := StorageShareResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.accessTierPremium(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("access_tier").HasValue("Premium"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r StorageShareResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.StorageShareDataPlaneID(state.ID)
 	if err != nil {
@@ -507,6 +560,61 @@ resource "azurerm_storage_share" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomString, data.RandomString, protocol)
 }
 
+func (r StorageShareResource) nfsProtocolStandardAccount(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_share" "test" {
+  name                 = "testshare%s"
+  storage_account_name = azurerm_storage_account.test.name
+  enabled_protocol     = "NFS"
+}
+`, template, data.RandomString)
+}
+
+func (r StorageShareResource) accessTierStandard(data acceptance.TestData, tier string) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_share" "test" {
+  name                 = "testshare%s"
+  storage_account_name = azurerm_storage_account.test.name
+  access_tier          = "%s"
+}
+`, template, data.RandomString, tier)
+}
+
+func (r StorageShareResource) accessTierPremium(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_kind             = "FileStorage"
+  account_tier             = "Premium"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_share" "test" {
+  name                 = "testshare%s"
+  storage_account_name = azurerm_storage_account.test.name
+  enabled_protocol     = "NFS"
+  access_tier          = "Premium"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, data.RandomString)
+}
+
 func (r StorageShareResource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -110,6 +110,11 @@ func resourceStorageTableCreate(d *pluginsdk.ResourceData, meta interface{}) err
 		return fmt.Errorf("unable to locate Storage Account %q!", accountName)
 	}
 
+	// the Table ACL endpoints don't support Azure AD authentication, which is required when Shared Key access is disabled
+	if account.SharedKeyAccessDisabled() && len(acls) > 0 {
+		return fmt.Errorf("`acl` cannot be specified for Storage Table %q since Shared Key access is disabled for Storage Account %q", tableName, accountName)
+	}
+
 	client, err := storageClient.TablesClient(ctx, *account)
 	if err != nil {
 		return fmt.Errorf("building Table Client: %s", err)
@@ -131,6 +136,11 @@ func resourceStorageTableCreate(d *pluginsdk.ResourceData, meta interface{}) err
 	}
 
 	d.SetId(id)
+
+	if account.SharedKeyAccessDisabled() {
+		return resourceStorageTableRead(d, meta)
+	}
+
 	if err := client.UpdateACLs(ctx, account.ResourceGroup, accountName, tableName, acls); err != nil {
 		return fmt.Errorf("setting ACL's for Storage Table %q (Account %q / Resource Group %q): %+v", tableName, accountName, account.ResourceGroup, err)
 	}
@@ -173,14 +183,20 @@ func resourceStorageTableRead(d *pluginsdk.ResourceData, meta interface{}) error
 		return nil
 	}
 
+	d.Set("name", id.Name)
+	d.Set("storage_account_name", id.AccountName)
+
+	// the Table ACL endpoints don't support Azure AD authentication, which is required when Shared Key access is disabled
+	if account.SharedKeyAccessDisabled() {
+		log.Printf("[DEBUG] Shared Key access is disabled for Storage Account %q - skipping retrieving the ACL's for Table %q", id.AccountName, id.Name)
+		return nil
+	}
+
 	acls, err := client.GetACLs(ctx, account.ResourceGroup, id.AccountName, id.Name)
 	if err != nil {
 		return fmt.Errorf("retrieving ACL's %q in Storage Account %q: %s", id.Name, id.AccountName, err)
 	}
 
-	d.Set("name", id.Name)
-	d.Set("storage_account_name", id.AccountName)
-
 	if err := d.Set("acl", flattenStorageTableACLs(acls)); err != nil {
 		return fmt.Errorf("flattening `acl`: %+v", err)
 	}
@@ -243,6 +259,10 @@ func resourceStorageTableUpdate(d *pluginsdk.ResourceData, meta interface{}) err
 	}
 
 	if d.HasChange("acl") {
+		if account.SharedKeyAccessDisabled() {
+			return fmt.Errorf("`acl` cannot be updated for Table %q since Shared Key access is disabled for Storage Account %q", id.Name, id.AccountName)
+		}
+
 		log.Printf("[DEBUG] Updating the ACL's for Storage Table %q (Storage Account %q)", id.Name, id.AccountName)
 
 		aclsRaw := d.Get("acl").(*pluginsdk.Set).List()
@@ -0,0 +1,42 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+// StorageAccountSASExpirationPeriod validates a SAS Expiration Period in the format `DD.HH:MM:SS`
+func StorageAccountSASExpirationPeriod(v interface{}, k string) (warnings []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	matches := regexp.MustCompile(`^(\d+)\.(\d{2}):(\d{2}):(\d{2})$`).FindStringSubmatch(value)
+	if matches == nil {
+		errors = append(errors, fmt.Errorf("%q must be in the format `DD.HH:MM:SS` but got %q", k, value))
+		return
+	}
+
+	days, _ := strconv.Atoi(matches[1])
+	hours, _ := strconv.Atoi(matches[2])
+	minutes, _ := strconv.Atoi(matches[3])
+	seconds, _ := strconv.Atoi(matches[4])
+
+	if hours > 23 {
+		errors = append(errors, fmt.Errorf("the hours component of %q must be between 0 and 23 but got %d", k, hours))
+	}
+	if minutes > 59 {
+		errors = append(errors, fmt.Errorf("the minutes component of %q must be between 0 and 59 but got %d", k, minutes))
+	}
+	if seconds > 59 {
+		errors = append(errors, fmt.Errorf("the seconds component of %q must be between 0 and 59 but got %d", k, seconds))
+	}
+	if days == 0 && hours == 0 && minutes == 0 && seconds == 0 {
+		errors = append(errors, fmt.Errorf("%q must be greater than zero", k))
+	}
+
+	return warnings, errors
+}
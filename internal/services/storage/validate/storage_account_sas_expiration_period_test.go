@@ -0,0 +1,62 @@
+package validate
+
+import "testing"
+
+func TestStorageAccountSASExpirationPeriod(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "",
+			ErrCount: 1,
+		},
+		{
+			Value:    "1.00:00:00",
+			ErrCount: 0,
+		},
+		{
+			Value:    "365.23:59:59",
+			ErrCount: 0,
+		},
+		{
+			Value:    "0.01:30:00",
+			ErrCount: 0,
+		},
+		{
+			Value:    "0.00:00:00",
+			ErrCount: 1,
+		},
+		{
+			Value:    "1.24:00:00",
+			ErrCount: 1,
+		},
+		{
+			Value:    "1.00:60:00",
+			ErrCount: 1,
+		},
+		{
+			Value:    "1.00:00:60",
+			ErrCount: 1,
+		},
+		{
+			Value:    "1.1:00:00",
+			ErrCount: 1,
+		},
+		{
+			Value:    "01:00:00",
+			ErrCount: 1,
+		},
+		{
+			Value:    "P1D",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := StorageAccountSASExpirationPeriod(tc.Value, "expiration_period")
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d errors for %q but got %d: %+v", tc.ErrCount, tc.Value, len(errors), errors)
+		}
+	}
+}
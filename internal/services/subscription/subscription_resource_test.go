@@ -93,6 +93,27 @@ func TestAccSubscriptionResource_devTest(t *testing.T) {
 	})
 }
 
+func TestAccSubscriptionResource_managementGroup(t *testing.T) {
+	if os.Getenv("ARM_BILLING_ACCOUNT") == "" {
+		t.Skip("skipping tests - no billing account data provided")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_subscription", "test")
+	r := SubscriptionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basicEnrollmentAccountManagementGroup(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("subscription_id").IsUUID(),
+				check.That("azurerm_management_group_subscription_association.test").Key("id").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (SubscriptionResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.SubscriptionAliasID(state.ID)
 	if err != nil {
@@ -119,8 +140,8 @@ provider "azurerm" {
 }
 
 data "azurerm_billing_enrollment_account_scope" "test" {
-  billing_account    = "%s"
-  enrollment_account = "%s"
+  billing_account_name    = "%s"
+  enrollment_account_name = "%s"
 }
 
 resource "azurerm_subscription" "test" {
@@ -140,8 +161,8 @@ provider "azurerm" {
 }
 
 data "azurerm_billing_enrollment_account_scope" "test" {
-  billing_account    = "%s"
-  enrollment_account = "%s"
+  billing_account_name    = "%s"
+  enrollment_account_name = "%s"
 }
 
 resource "azurerm_subscription" "test" {
@@ -174,6 +195,37 @@ resource "azurerm_subscription" "test" {
 `, billingAccount, enrollmentAccount, data.RandomInteger)
 }
 
+func (SubscriptionResource) basicEnrollmentAccountManagementGroup(data acceptance.TestData) string {
+	billingAccount := os.Getenv("ARM_BILLING_ACCOUNT")
+	enrollmentAccount := os.Getenv("ARM_BILLING_ENROLLMENT_ACCOUNT")
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_billing_enrollment_account_scope" "test" {
+  billing_account_name    = "%s"
+  enrollment_account_name = "%s"
+}
+
+resource "azurerm_management_group" "test" {
+  display_name = "acctestmg-%[3]d"
+}
+
+resource "azurerm_subscription" "test" {
+  alias             = "testAcc-%[3]d"
+  subscription_name = "testAccSubscription %[3]d"
+  billing_scope_id  = data.azurerm_billing_enrollment_account_scope.test.id
+  workload          = "Production"
+}
+
+resource "azurerm_management_group_subscription_association" "test" {
+  management_group_id = azurerm_management_group.test.id
+  subscription_id     = "/subscriptions/${azurerm_subscription.test.subscription_id}"
+}
+`, billingAccount, enrollmentAccount, data.RandomInteger)
+}
+
 func (r SubscriptionResource) requiresImport(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -0,0 +1,63 @@
+package tags
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// systemTagPrefixes are the (lower-cased) prefixes of Tags which Azure injects into some resources
+// e.g. `hidden-link:` and `azsecpack`
+var systemTagPrefixes = []string{
+	"hidden-",
+	"azsecpack",
+}
+
+// IsSystemTag returns whether the Tag with the specified key is injected by Azure rather than set by the user
+func IsSystemTag(key string) bool {
+	key = strings.ToLower(key)
+	for _, prefix := range systemTagPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// SchemaIgnoringSystemTags returns the Schema used for Tags on resources where Azure injects system Tags
+// (see IsSystemTag), which are suppressed from the diff unless they're specified in the configuration
+func SchemaIgnoringSystemTags() *pluginsdk.Schema {
+	s := Schema()
+	s.DiffSuppressFunc = SuppressSystemTagsDiff
+	return s
+}
+
+// SuppressSystemTagsDiff is a DiffSuppressFunc for a Tags map which ignores system Tags injected by Azure
+// which aren't specified in the configuration, whilst still showing diffs for any other Tags
+func SuppressSystemTagsDiff(k, old, new string, d *pluginsdk.ResourceData) bool {
+	i := strings.Index(k, ".")
+	if i == -1 {
+		return false
+	}
+	field, key := k[:i], k[i+1:]
+
+	// the number of elements in the map differs whenever a system Tag has been injected, so compare
+	// the number of Tags excluding the system Tags which aren't in the configuration
+	if key == "%" {
+		o, n := d.GetChange(field)
+		oldTags, _ := o.(map[string]interface{})
+		newTags, _ := n.(map[string]interface{})
+
+		oldCount := 0
+		for tagKey := range oldTags {
+			if _, ok := newTags[tagKey]; ok || !IsSystemTag(tagKey) {
+				oldCount++
+			}
+		}
+
+		return oldCount == len(newTags)
+	}
+
+	return new == "" && IsSystemTag(key)
+}
@@ -0,0 +1,141 @@
+package tags
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func TestIsSystemTag(t *testing.T) {
+	testData := []struct {
+		key      string
+		expected bool
+	}{
+		{key: "hidden-link:/subscriptions/00000000-0000-0000-0000-000000000000", expected: true},
+		{key: "hidden-title", expected: true},
+		{key: "Hidden-Title", expected: true},
+		{key: "azsecpack", expected: true},
+		{key: "AzSecPackAutoConfigReady", expected: true},
+		{key: "environment", expected: false},
+		{key: "not-hidden-link", expected: false},
+		{key: "hidden", expected: false},
+	}
+
+	for _, v := range testData {
+		if actual := IsSystemTag(v.key); actual != v.expected {
+			t.Fatalf("expected IsSystemTag(%q) to be %t but got %t", v.key, v.expected, actual)
+		}
+	}
+}
+
+func TestSuppressSystemTagsDiff(t *testing.T) {
+	testData := []struct {
+		name         string
+		state        map[string]string
+		config       map[string]interface{}
+		expectedDiff []string
+	}{
+		{
+			name: "system tags injected",
+			state: map[string]string{
+				"tags.%":                    "3",
+				"tags.environment":          "production",
+				"tags.hidden-link:/example": "Resource",
+				"tags.azsecpack":            "nonprod",
+			},
+			config: map[string]interface{}{
+				"environment": "production",
+			},
+		},
+		{
+			name: "user tag changed alongside system tags",
+			state: map[string]string{
+				"tags.%":                    "2",
+				"tags.environment":          "production",
+				"tags.hidden-link:/example": "Resource",
+			},
+			config: map[string]interface{}{
+				"environment": "staging",
+			},
+			expectedDiff: []string{"tags.environment"},
+		},
+		{
+			name: "user tag added alongside system tags",
+			state: map[string]string{
+				"tags.%":                    "2",
+				"tags.environment":          "production",
+				"tags.hidden-link:/example": "Resource",
+			},
+			config: map[string]interface{}{
+				"environment": "production",
+				"owner":       "me",
+			},
+			expectedDiff: []string{"tags.owner"},
+		},
+		{
+			name: "user tag removed alongside system tags",
+			state: map[string]string{
+				"tags.%":                    "3",
+				"tags.environment":          "production",
+				"tags.owner":                "me",
+				"tags.hidden-link:/example": "Resource",
+			},
+			config: map[string]interface{}{
+				"environment": "production",
+			},
+			expectedDiff: []string{"tags.%", "tags.owner"},
+		},
+		{
+			name: "system tag set by the user is changed",
+			state: map[string]string{
+				"tags.%":            "1",
+				"tags.hidden-title": "Example",
+			},
+			config: map[string]interface{}{
+				"hidden-title": "Updated",
+			},
+			expectedDiff: []string{"tags.hidden-title"},
+		},
+	}
+
+	resource := &pluginsdk.Resource{
+		Schema: map[string]*pluginsdk.Schema{
+			"tags": SchemaIgnoringSystemTags(),
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		state := &terraform.InstanceState{
+			ID:         "example",
+			Attributes: v.state,
+		}
+		config := terraform.NewResourceConfigRaw(map[string]interface{}{
+			"tags": v.config,
+		})
+
+		diff, err := resource.SimpleDiff(context.TODO(), state, config, nil)
+		if err != nil {
+			t.Fatalf("computing the diff: %+v", err)
+		}
+
+		actual := make(map[string]struct{})
+		if diff != nil {
+			for k := range diff.Attributes {
+				actual[k] = struct{}{}
+			}
+		}
+
+		if len(actual) != len(v.expectedDiff) {
+			t.Fatalf("expected the diff to contain %v but got %v", v.expectedDiff, diff)
+		}
+		for _, k := range v.expectedDiff {
+			if _, ok := actual[k]; !ok {
+				t.Fatalf("expected the diff to contain %q but got %v", k, diff)
+			}
+		}
+	}
+}
@@ -68,3 +68,29 @@ func ForceNewIfChange(key string, f ValueChangeConditionFunc) CustomizeDiffFunc
 		return nil
 	}
 }
+
+// ForceNewIfChanged returns a CustomizeDiffFunc that flags each of the given
+// keys as requiring a new resource when its value has changed.
+//
+// This is intended for resources where only some fields are immutable, since
+// these can't be marked as ForceNew in the Schema when the same field is also
+// used to compute other values during an update.
+func ForceNewIfChanged(keys ...string) CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		// there's nothing to recreate when the resource is being created
+		if d.Id() == "" {
+			return nil
+		}
+
+		for _, key := range keys {
+			if !d.HasChange(key) {
+				continue
+			}
+
+			if err := d.ForceNew(key); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
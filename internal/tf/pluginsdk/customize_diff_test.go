@@ -0,0 +1,102 @@
+package pluginsdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestForceNewIfChanged(t *testing.T) {
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"immutable": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"other_immutable": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"mutable": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+		CustomizeDiff: ForceNewIfChanged("immutable", "other_immutable"),
+	}
+
+	testData := []struct {
+		name                string
+		state               *terraform.InstanceState
+		config              map[string]interface{}
+		expectedRequiresNew bool
+	}{
+		{
+			name: "new resource",
+			config: map[string]interface{}{
+				"immutable": "a",
+				"mutable":   "b",
+			},
+			expectedRequiresNew: false,
+		},
+		{
+			name: "mutable field changed",
+			state: &terraform.InstanceState{
+				ID: "example",
+				Attributes: map[string]string{
+					"immutable": "a",
+					"mutable":   "b",
+				},
+			},
+			config: map[string]interface{}{
+				"immutable": "a",
+				"mutable":   "c",
+			},
+			expectedRequiresNew: false,
+		},
+		{
+			name: "immutable field changed",
+			state: &terraform.InstanceState{
+				ID: "example",
+				Attributes: map[string]string{
+					"immutable": "a",
+					"mutable":   "b",
+				},
+			},
+			config: map[string]interface{}{
+				"immutable": "changed",
+				"mutable":   "b",
+			},
+			expectedRequiresNew: true,
+		},
+		{
+			name: "other immutable field added",
+			state: &terraform.InstanceState{
+				ID: "example",
+				Attributes: map[string]string{
+					"immutable": "a",
+				},
+			},
+			config: map[string]interface{}{
+				"immutable":       "a",
+				"other_immutable": "added",
+			},
+			expectedRequiresNew: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		diff, err := resource.Diff(context.TODO(), v.state, terraform.NewResourceConfigRaw(v.config), nil)
+		if err != nil {
+			t.Fatalf("computing the diff: %+v", err)
+		}
+
+		if actual := diff.RequiresNew(); actual != v.expectedRequiresNew {
+			t.Fatalf("expected the diff to require a new resource to be %t but got %t", v.expectedRequiresNew, actual)
+		}
+	}
+}
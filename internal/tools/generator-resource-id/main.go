@@ -260,6 +260,9 @@ func NewResourceID(typeName, servicePackageName, resourceId string) (*ResourceId
 				if strings.HasSuffix(key, "sses") {
 					key = strings.TrimSuffix(key, "sses")
 					key = fmt.Sprintf("%sss", key)
+				} else if strings.HasSuffix(key, "xes") {
+					// handles `PublicIPPrefixesName`
+					key = strings.TrimSuffix(key, "es")
 				} else {
 					key = strings.TrimSuffix(key, "s")
 				}